@@ -98,7 +98,7 @@ resource "litellm_model" "embedding" {
 resource "litellm_organization" "enterprise" {
   organization_alias = "enterprise-org"
   max_budget         = 10000.0
-  budget_duration    = "monthly"
+  budget_duration    = "1mo"
   models = [
     litellm_model.gpt4.model_name,
     litellm_model.gpt35.model_name,
@@ -111,7 +111,7 @@ resource "litellm_team" "engineering" {
   team_alias      = "engineering"
   organization_id = litellm_organization.enterprise.organization_id
   max_budget      = 3000.0
-  budget_duration = "monthly"
+  budget_duration = "1mo"
   models = [
     litellm_model.gpt4.model_name,
     litellm_model.gpt35.model_name,
@@ -125,7 +125,7 @@ resource "litellm_team" "data_science" {
   team_alias      = "data-science"
   organization_id = litellm_organization.enterprise.organization_id
   max_budget      = 2000.0
-  budget_duration = "monthly"
+  budget_duration = "1mo"
   models = [
     litellm_model.gpt4.model_name,
     litellm_model.embedding.model_name
@@ -136,7 +136,7 @@ resource "litellm_team" "support" {
   team_alias      = "customer-support"
   organization_id = litellm_organization.enterprise.organization_id
   max_budget      = 500.0
-  budget_duration = "monthly"
+  budget_duration = "1mo"
   models = [
     litellm_model.gpt35.model_name
   ]
@@ -184,7 +184,7 @@ resource "litellm_key" "engineering_api" {
   key_alias       = "engineering-production"
   team_id         = litellm_team.engineering.team_id
   max_budget      = 1000.0
-  budget_duration = "monthly"
+  budget_duration = "1mo"
   tpm_limit       = 100000
   rpm_limit       = 1000
   tags            = ["production", "engineering"]
@@ -194,7 +194,7 @@ resource "litellm_key" "support_api" {
   key_alias       = "support-api-key"
   team_id         = litellm_team.support.team_id
   max_budget      = 200.0
-  budget_duration = "monthly"
+  budget_duration = "1mo"
   tags            = ["production", "support"]
 }
 
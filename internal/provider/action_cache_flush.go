@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+var _ action.Action = &CacheFlushAction{}
+var _ action.ActionWithConfigure = &CacheFlushAction{}
+
+func NewCacheFlushAction() action.Action {
+	return &CacheFlushAction{}
+}
+
+// CacheFlushAction flushes the proxy cache through /cache/flushall.
+type CacheFlushAction struct {
+	client *Client
+}
+
+func (a *CacheFlushAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cache_flush"
+}
+
+func (a *CacheFlushAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Flushes all entries from the LiteLLM proxy cache. Fails when no cache is configured.",
+	}
+}
+
+func (a *CacheFlushAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *CacheFlushAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if err := a.client.DoRequestWithResponse(ctx, "POST", "/cache/flushall", nil, nil); err != nil {
+		addClientError(ctx, &resp.Diagnostics, nil, "Unable to flush cache", err)
+		return
+	}
+
+	sendActionProgress(resp, "Flushed the LiteLLM cache")
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+var _ action.Action = &GlobalSpendResetAction{}
+var _ action.ActionWithConfigure = &GlobalSpendResetAction{}
+
+func NewGlobalSpendResetAction() action.Action {
+	return &GlobalSpendResetAction{}
+}
+
+// GlobalSpendResetAction resets the spend of every key, team and user through
+// /global/spend/reset.
+type GlobalSpendResetAction struct {
+	client *Client
+}
+
+func (a *GlobalSpendResetAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_spend_reset"
+}
+
+func (a *GlobalSpendResetAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resets the spend of all keys, teams and users to 0. Spend logs are kept. Requires a proxy admin key.",
+	}
+}
+
+func (a *GlobalSpendResetAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *GlobalSpendResetAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if err := a.client.DoRequestWithResponse(ctx, "POST", "/global/spend/reset", nil, nil); err != nil {
+		addClientError(ctx, &resp.Diagnostics, nil, "Unable to reset global spend", err)
+		return
+	}
+
+	sendActionProgress(resp, "Reset the spend of all keys, teams and users")
+}
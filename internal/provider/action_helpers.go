@@ -0,0 +1,11 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/action"
+
+// sendActionProgress reports a progress message for an action invocation.
+func sendActionProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress == nil {
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &KeyRegenerateAction{}
+var _ action.ActionWithConfigure = &KeyRegenerateAction{}
+
+func NewKeyRegenerateAction() action.Action {
+	return &KeyRegenerateAction{}
+}
+
+// KeyRegenerateAction regenerates an existing API key through /key/{key}/regenerate.
+// The old key stops working immediately unless a grace period is set.
+type KeyRegenerateAction struct {
+	client *Client
+}
+
+type KeyRegenerateActionModel struct {
+	Key         types.String `tfsdk:"key"`
+	NewKey      types.String `tfsdk:"new_key"`
+	Duration    types.String `tfsdk:"duration"`
+	GracePeriod types.String `tfsdk:"grace_period"`
+}
+
+func (a *KeyRegenerateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_regenerate"
+}
+
+func (a *KeyRegenerateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Regenerates a LiteLLM API key. Settings, spend and budgets are kept; only the key value changes.",
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Description: "The key to regenerate: the raw key value or its hashed token.",
+				Required:    true,
+				WriteOnly:   true,
+			},
+			"new_key": schema.StringAttribute{
+				Description: "Value for the regenerated key (must start with 'sk-'). Required because actions cannot return the key LiteLLM would otherwise generate.",
+				Required:    true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^sk-`), "must start with 'sk-'"),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Description: "New validity duration for the key (e.g., '30d').",
+				Optional:    true,
+			},
+			"grace_period": schema.StringAttribute{
+				Description: "How long the old key keeps working after regeneration (e.g., '24h').",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *KeyRegenerateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *KeyRegenerateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data KeyRegenerateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := a.regenerateKey(ctx, &data)
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Config.Schema, "Unable to regenerate key", err)
+		return
+	}
+
+	sendActionProgress(resp, fmt.Sprintf("Regenerated key %s", token))
+}
+
+// regenerateKey regenerates the key and returns the hashed token of the new
+// key, which is safe to report.
+func (a *KeyRegenerateAction) regenerateKey(ctx context.Context, data *KeyRegenerateActionModel) (string, error) {
+	regenerateReq := map[string]interface{}{
+		"new_key": data.NewKey.ValueString(),
+	}
+	if !data.Duration.IsNull() && data.Duration.ValueString() != "" {
+		regenerateReq["duration"] = data.Duration.ValueString()
+	}
+	if !data.GracePeriod.IsNull() && data.GracePeriod.ValueString() != "" {
+		regenerateReq["grace_period"] = data.GracePeriod.ValueString()
+	}
+
+	newKey, err := a.client.regenerateKey(ctx, data.Key.ValueString(), regenerateReq)
+	if err != nil {
+		return "", err
+	}
+	return keyToken(newKey), nil
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &KeyResetSpendAction{}
+var _ action.ActionWithConfigure = &KeyResetSpendAction{}
+
+func NewKeyResetSpendAction() action.Action {
+	return &KeyResetSpendAction{}
+}
+
+// KeyResetSpendAction resets the spend of an API key through /key/{key}/reset_spend.
+type KeyResetSpendAction struct {
+	client *Client
+}
+
+type KeyResetSpendActionModel struct {
+	Key     types.String  `tfsdk:"key"`
+	ResetTo types.Float64 `tfsdk:"reset_to"`
+}
+
+func (a *KeyResetSpendAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_reset_spend"
+}
+
+func (a *KeyResetSpendAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resets the spend tracked for a LiteLLM API key.",
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Description: "The key whose spend to reset: the raw key value or its hashed token.",
+				Required:    true,
+				WriteOnly:   true,
+			},
+			"reset_to": schema.Float64Attribute{
+				Description: "Spend value to reset to. Defaults to 0 and may not exceed the current spend.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *KeyResetSpendAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *KeyResetSpendAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data KeyResetSpendActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resetTo := 0.0
+	if !data.ResetTo.IsNull() {
+		resetTo = data.ResetTo.ValueFloat64()
+	}
+
+	resetReq := map[string]interface{}{
+		"reset_to": resetTo,
+	}
+	endpoint := fmt.Sprintf("/key/%s/reset_spend", url.PathEscape(data.Key.ValueString()))
+	if err := a.client.DoRequestWithResponse(ctx, "POST", endpoint, resetReq, nil); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Config.Schema, "Unable to reset key spend", err)
+		return
+	}
+
+	sendActionProgress(resp, fmt.Sprintf("Reset spend of key %s to %g", keyToken(data.Key.ValueString()), resetTo))
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.Action = &TeamBlockAction{}
+var _ action.ActionWithConfigure = &TeamBlockAction{}
+
+func NewTeamBlockAction() action.Action {
+	return &TeamBlockAction{}
+}
+
+// TeamBlockAction blocks or unblocks a team through /team/block and /team/unblock.
+// Unlike the litellm_team_block resource it does not track the team afterwards.
+type TeamBlockAction struct {
+	client *Client
+}
+
+type TeamBlockActionModel struct {
+	TeamID  types.String `tfsdk:"team_id"`
+	Blocked types.Bool   `tfsdk:"blocked"`
+}
+
+func (a *TeamBlockAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_block"
+}
+
+func (a *TeamBlockAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Blocks or unblocks a LiteLLM team. All API requests using the team's keys are rejected while it is blocked.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description: "ID of the team to block or unblock.",
+				Required:    true,
+			},
+			"blocked": schema.BoolAttribute{
+				Description: "Whether to block (true) or unblock (false) the team. Defaults to true.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *TeamBlockAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *TeamBlockAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data TeamBlockActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint, operation, done := "/team/block", "block", "Blocked"
+	if !data.Blocked.IsNull() && !data.Blocked.ValueBool() {
+		endpoint, operation, done = "/team/unblock", "unblock", "Unblocked"
+	}
+
+	blockReq := map[string]interface{}{
+		"team_id": data.TeamID.ValueString(),
+	}
+	if err := a.client.DoRequestWithResponse(ctx, "POST", endpoint, blockReq, nil); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Config.Schema, fmt.Sprintf("Unable to %s team", operation), err)
+		return
+	}
+
+	sendActionProgress(resp, fmt.Sprintf("%s team %s", done, data.TeamID.ValueString()))
+}
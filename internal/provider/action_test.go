@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type actionCall struct {
+	Method string
+	Path   string
+	Body   map[string]interface{}
+}
+
+// invokeAction invokes a against a test server and returns the requests the
+// action sent and the progress messages it reported. values holds the
+// configured attributes; all others are null.
+func invokeAction(t *testing.T, a action.ActionWithConfigure, values map[string]tftypes.Value) ([]actionCall, []string) {
+	t.Helper()
+
+	var calls []actionCall
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := actionCall{Method: r.Method, Path: r.URL.EscapedPath()}
+		_ = json.NewDecoder(r.Body).Decode(&call.Body)
+		calls = append(calls, call)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"key": "sk-regenerated"})
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	var configureResp action.ConfigureResponse
+	a.Configure(ctx, action.ConfigureRequest{
+		ProviderData: &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()},
+	}, &configureResp)
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, attrType := range objType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	var progress []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Raw: tftypes.NewValue(objType, attrs), Schema: schemaResp.Schema},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	return calls, progress
+}
+
+func TestKeyRegenerateAction(t *testing.T) {
+	t.Parallel()
+
+	calls, progress := invokeAction(t, &KeyRegenerateAction{}, map[string]tftypes.Value{
+		"key":          tftypes.NewValue(tftypes.String, "sk-old"),
+		"new_key":      tftypes.NewValue(tftypes.String, "sk-regenerated"),
+		"grace_period": tftypes.NewValue(tftypes.String, "24h"),
+	})
+
+	if len(calls) != 1 || calls[0].Method != http.MethodPost || calls[0].Path != "/key/sk-old/regenerate" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	if calls[0].Body["grace_period"] != "24h" {
+		t.Fatalf("expected grace_period in body, got %v", calls[0].Body)
+	}
+	if calls[0].Body["new_key"] != "sk-regenerated" {
+		t.Fatalf("expected new_key in body, got %v", calls[0].Body)
+	}
+	// The new key must never be reported, only its hashed token.
+	if len(progress) != 1 || progress[0] != "Regenerated key "+keyToken("sk-regenerated") {
+		t.Fatalf("unexpected progress: %v", progress)
+	}
+}
+
+func TestKeyResetSpendActionDefaultsToZero(t *testing.T) {
+	t.Parallel()
+
+	calls, _ := invokeAction(t, &KeyResetSpendAction{}, map[string]tftypes.Value{
+		"key": tftypes.NewValue(tftypes.String, "token-1"),
+	})
+
+	if len(calls) != 1 || calls[0].Path != "/key/token-1/reset_spend" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	if calls[0].Body["reset_to"] != float64(0) {
+		t.Fatalf("expected reset_to 0, got %v", calls[0].Body["reset_to"])
+	}
+}
+
+func TestTeamBlockAction(t *testing.T) {
+	t.Parallel()
+
+	calls, _ := invokeAction(t, &TeamBlockAction{}, map[string]tftypes.Value{
+		"team_id": tftypes.NewValue(tftypes.String, "team-1"),
+	})
+	if len(calls) != 1 || calls[0].Path != "/team/block" || calls[0].Body["team_id"] != "team-1" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+
+	calls, progress := invokeAction(t, &TeamBlockAction{}, map[string]tftypes.Value{
+		"team_id": tftypes.NewValue(tftypes.String, "team-1"),
+		"blocked": tftypes.NewValue(tftypes.Bool, false),
+	})
+	if len(calls) != 1 || calls[0].Path != "/team/unblock" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	if len(progress) != 1 || progress[0] != "Unblocked team team-1" {
+		t.Fatalf("unexpected progress: %v", progress)
+	}
+}
+
+func TestCacheFlushAndGlobalSpendResetActions(t *testing.T) {
+	t.Parallel()
+
+	calls, _ := invokeAction(t, &CacheFlushAction{}, nil)
+	if len(calls) != 1 || calls[0].Method != http.MethodPost || calls[0].Path != "/cache/flushall" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+
+	calls, _ = invokeAction(t, &GlobalSpendResetAction{}, nil)
+	if len(calls) != 1 || calls[0].Method != http.MethodPost || calls[0].Path != "/global/spend/reset" {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+}
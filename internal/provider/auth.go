@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpirySkew refreshes OAuth2 tokens slightly before they expire so a
+// request never goes out with a token that lapses in flight.
+const tokenExpirySkew = 30 * time.Second
+
+// credentialSource supplies the credential sent with each API request when
+// the provider is configured with an auth block instead of a static api_key.
+type credentialSource interface {
+	// Credential returns the current credential, fetching or refreshing it
+	// when necessary.
+	Credential(ctx context.Context) (string, error)
+	// Invalidate discards a cached credential after the API rejected it.
+	Invalidate()
+	// BearerOnly reports whether the credential must only be sent as an
+	// Authorization bearer token (and not as x-api-key).
+	BearerOnly() bool
+}
+
+// oauth2ClientCredentialsSource obtains JWT access tokens using the OAuth2
+// client-credentials grant and caches them until shortly before expiry.
+type oauth2ClientCredentialsSource struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	Audience     string
+	HTTPClient   *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func (s *oauth2ClientCredentialsSource) Credential(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expires.IsZero() || time.Now().Before(s.expires)) {
+		return s.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(s.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.Scopes, " "))
+	}
+	if s.Audience != "" {
+		form.Set("audience", s.Audience)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(s.ClientID), url.QueryEscape(s.ClientSecret))
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request OAuth2 token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read OAuth2 token response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("OAuth2 token request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var tokenResp struct {
+		AccessToken string      `json:"access_token"`
+		ExpiresIn   json.Number `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", fmt.Errorf("failed to parse OAuth2 token response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("OAuth2 token response did not include an access_token")
+	}
+
+	s.token = tokenResp.AccessToken
+	s.expires = time.Time{}
+	if seconds, err := tokenResp.ExpiresIn.Int64(); err == nil && seconds > 0 {
+		lifetime := time.Duration(seconds) * time.Second
+		if lifetime > 2*tokenExpirySkew {
+			lifetime -= tokenExpirySkew
+		}
+		s.expires = time.Now().Add(lifetime)
+	}
+
+	return s.token, nil
+}
+
+func (s *oauth2ClientCredentialsSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+func (s *oauth2ClientCredentialsSource) BearerOnly() bool { return true }
+
+// commandCredentialSource runs an external credential helper and uses its
+// trimmed stdout as the API key. The key is cached for the lifetime of the
+// provider process and re-read after the API rejects it.
+type commandCredentialSource struct {
+	Command []string
+
+	mu  sync.Mutex
+	key string
+}
+
+func (s *commandCredentialSource) Credential(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.key != "" {
+		return s.key, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.Command[0], s.Command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return "", fmt.Errorf("api_key_command %q failed: %w", s.Command[0], err)
+		}
+		return "", fmt.Errorf("api_key_command %q failed: %w: %s", s.Command[0], err, msg)
+	}
+
+	key := strings.TrimSpace(stdout.String())
+	if key == "" {
+		return "", fmt.Errorf("api_key_command %q produced no output", s.Command[0])
+	}
+	s.key = key
+	return s.key, nil
+}
+
+func (s *commandCredentialSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.key = ""
+}
+
+func (s *commandCredentialSource) BearerOnly() bool { return false }
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOAuth2ClientCredentialsSource_cachesAndRefreshesToken(t *testing.T) {
+	t.Parallel()
+
+	var tokenCalls int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&tokenCalls, 1)
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "terraform" || secret != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("scope") != "litellm.admin openid" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": map[int32]string{1: "jwt-1", 2: "jwt-2"}[n],
+			"expires_in":   3600,
+		})
+	}))
+	defer tokenServer.Close()
+
+	var authHeaders []string
+	var sawAPIKeyHeader bool
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		if r.Header.Get("x-api-key") != "" {
+			sawAPIKeyHeader = true
+		}
+		// Reject the first token to exercise re-authentication.
+		if r.Header.Get("Authorization") == "Bearer jwt-1" && len(authHeaders) > 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer apiServer.Close()
+
+	client := &Client{
+		APIBase:    apiServer.URL,
+		HTTPClient: apiServer.Client(),
+		Credentials: &oauth2ClientCredentialsSource{
+			TokenURL:     tokenServer.URL,
+			ClientID:     "terraform",
+			ClientSecret: "s3cret",
+			Scopes:       []string{"litellm.admin", "openid"},
+			HTTPClient:   tokenServer.Client(),
+		},
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := client.DoRequestWithResponse(ctx, "GET", "/team/info", nil, nil); err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+	}
+
+	want := []string{"Bearer jwt-1", "Bearer jwt-1", "Bearer jwt-2"}
+	if len(authHeaders) != len(want) {
+		t.Fatalf("Authorization headers = %v, want %v", authHeaders, want)
+	}
+	for i := range want {
+		if authHeaders[i] != want[i] {
+			t.Errorf("request %d Authorization = %q, want %q", i+1, authHeaders[i], want[i])
+		}
+	}
+	if got := atomic.LoadInt32(&tokenCalls); got != 2 {
+		t.Errorf("token requests = %d, want 2 (cached once, refreshed after 401)", got)
+	}
+	if sawAPIKeyHeader {
+		t.Error("OAuth2 tokens should not be sent as x-api-key")
+	}
+}
+
+func TestOAuth2ClientCredentialsSource_tokenEndpointError(t *testing.T) {
+	t.Parallel()
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer tokenServer.Close()
+
+	source := &oauth2ClientCredentialsSource{TokenURL: tokenServer.URL, ClientID: "a", ClientSecret: "b", HTTPClient: tokenServer.Client()}
+	if _, err := source.Credential(context.Background()); err == nil {
+		t.Fatal("expected error from token endpoint")
+	}
+}
+
+func TestCommandCredentialSource(t *testing.T) {
+	t.Parallel()
+
+	source := &commandCredentialSource{Command: []string{"echo", "  sk-from-helper  "}}
+	key, err := source.Credential(context.Background())
+	if err != nil {
+		t.Fatalf("Credential: %v", err)
+	}
+	if key != "sk-from-helper" {
+		t.Errorf("key = %q, want sk-from-helper", key)
+	}
+
+	failing := &commandCredentialSource{Command: []string{"sh", "-c", "echo vault sealed >&2; exit 2"}}
+	if _, err := failing.Credential(context.Background()); err == nil {
+		t.Error("expected error from failing helper")
+	}
+
+	empty := &commandCredentialSource{Command: []string{"true"}}
+	if _, err := empty.Credential(context.Background()); err == nil {
+		t.Error("expected error when helper prints nothing")
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BudgetLimitModel is one budget window of a key, team or user. Each window
+// has its own limit and resets independently of max_budget/budget_duration.
+// Per-model budgets are set through model_max_budget instead.
+type BudgetLimitModel struct {
+	MaxBudget      types.Float64 `tfsdk:"max_budget"`
+	BudgetDuration types.String  `tfsdk:"budget_duration"`
+}
+
+var budgetLimitAttrTypes = map[string]attr.Type{
+	"max_budget":      types.Float64Type,
+	"budget_duration": types.StringType,
+}
+
+// budgetLimitsSchemaAttribute returns the budget_limits attribute shared by
+// keys, teams and users.
+func budgetLimitsSchemaAttribute(entity string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Budget windows for the " + entity + ", each with its own limit and reset period " +
+			"(e.g. a daily and a monthly cap). They apply in addition to max_budget and budget_duration.",
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"max_budget": schema.Float64Attribute{
+					Description: "Maximum spend within the window.",
+					Required:    true,
+				},
+				"budget_duration": schema.StringAttribute{
+					Description: "Length of the window after which spend resets (e.g., '1d', '1mo').",
+					Required:    true,
+					Validators: []validator.String{
+						litellmDuration(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildBudgetLimitsPayload converts budget_limits into the API format.
+func buildBudgetLimitsPayload(ctx context.Context, list types.List) []map[string]interface{} {
+	var limits []BudgetLimitModel
+	list.ElementsAs(ctx, &limits, false)
+
+	payload := make([]map[string]interface{}, 0, len(limits))
+	for _, limit := range limits {
+		payload = append(payload, map[string]interface{}{
+			"max_budget":      limit.MaxBudget.ValueFloat64(),
+			"budget_duration": limit.BudgetDuration.ValueString(),
+		})
+	}
+	return payload
+}
+
+// parseBudgetLimitsFromAPI converts the budget_limits returned by LiteLLM
+// into a list. Like budget_duration, they are only read back once configured
+// so windows LiteLLM fills in on its own never show up as drift; reset_at is
+// server-managed and not tracked.
+func parseBudgetLimitsFromAPI(raw interface{}, prior types.List) types.List {
+	elemType := types.ObjectType{AttrTypes: budgetLimitAttrTypes}
+	if prior.IsNull() {
+		return prior
+	}
+
+	items, _ := raw.([]interface{})
+	limits := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		maxBudget, _ := entry["max_budget"].(float64)
+		duration, _ := entry["budget_duration"].(string)
+		limits = append(limits, types.ObjectValueMust(budgetLimitAttrTypes, map[string]attr.Value{
+			"max_budget":      types.Float64Value(maxBudget),
+			"budget_duration": types.StringValue(duration),
+		}))
+	}
+	return types.ListValueMust(elemType, limits)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type budgetLimit struct {
+	amount   float64
+	duration string
+}
+
+func budgetLimitsList(t *testing.T, limits ...budgetLimit) types.List {
+	t.Helper()
+
+	elems := make([]attr.Value, 0, len(limits))
+	for _, limit := range limits {
+		elems = append(elems, types.ObjectValueMust(budgetLimitAttrTypes, map[string]attr.Value{
+			"max_budget":      types.Float64Value(limit.amount),
+			"budget_duration": types.StringValue(limit.duration),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: budgetLimitAttrTypes}, elems)
+}
+
+func TestBuildBudgetLimitsPayload(t *testing.T) {
+	t.Parallel()
+
+	payload := buildBudgetLimitsPayload(context.Background(), budgetLimitsList(t,
+		budgetLimit{amount: 10, duration: "1d"},
+		budgetLimit{amount: 200, duration: "1mo"},
+	))
+	if len(payload) != 2 || payload[0]["max_budget"] != 10.0 || payload[0]["budget_duration"] != "1d" {
+		t.Fatalf("unexpected payload: %v", payload)
+	}
+	if len(payload[1]) != 2 || payload[1]["max_budget"] != 200.0 || payload[1]["budget_duration"] != "1mo" {
+		t.Errorf("unexpected second window: %v", payload[1])
+	}
+}
+
+func TestParseBudgetLimitsFromAPI(t *testing.T) {
+	t.Parallel()
+
+	raw := []interface{}{
+		map[string]interface{}{"max_budget": 10.0, "budget_duration": "1d", "reset_at": "2026-01-02T00:00:00Z"},
+		map[string]interface{}{"max_budget": 200.0, "budget_duration": "1mo"},
+	}
+	elemType := types.ObjectType{AttrTypes: budgetLimitAttrTypes}
+
+	if got := parseBudgetLimitsFromAPI(raw, types.ListNull(elemType)); !got.IsNull() {
+		t.Fatalf("budget_limits should stay null when unconfigured, got %v", got)
+	}
+
+	got := parseBudgetLimitsFromAPI(raw, budgetLimitsList(t, budgetLimit{amount: 10, duration: "1d"}))
+	if len(got.Elements()) != 2 {
+		t.Fatalf("expected all windows to be read back, got %v", got)
+	}
+	var limits []BudgetLimitModel
+	got.ElementsAs(context.Background(), &limits, false)
+	if limits[1].BudgetDuration.ValueString() != "1mo" || limits[1].MaxBudget.ValueFloat64() != 200 {
+		t.Fatalf("unexpected second window: %+v", limits[1])
+	}
+
+	if got := parseBudgetLimitsFromAPI(nil, types.ListUnknown(elemType)); got.IsUnknown() || len(got.Elements()) != 0 {
+		t.Fatalf("expected an unknown value to resolve to an empty list, got %v", got)
+	}
+}
+
+func TestApplyTeamNullableClearsBudgetLimits(t *testing.T) {
+	t.Parallel()
+
+	teamReq := map[string]interface{}{}
+	applyTeamNullableClears(teamReq,
+		&TeamResourceModel{BudgetLimits: budgetLimitsList(t, budgetLimit{amount: 10, duration: "1d"})},
+		&TeamResourceModel{BudgetLimits: types.ListNull(types.ObjectType{AttrTypes: budgetLimitAttrTypes})})
+
+	if v, ok := teamReq["budget_limits"]; !ok || v != nil {
+		t.Fatalf("expected budget_limits to be cleared with null, got %v", teamReq)
+	}
+}
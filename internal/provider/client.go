@@ -4,44 +4,159 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Default retry policy used when the provider block does not override it.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
 )
 
 // DoRequest performs an HTTP request with context and standard headers.
+// Transient failures (HTTP 429 and retryable 5xx responses, see
+// isRetryableStatus) are retried according to the client's retry policy,
+// honoring any Retry-After header.
 func (c *Client) DoRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
 	url := c.APIBase + path
 
-	var req *http.Request
-	var err error
-
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonBody))
+	}
+
+	reauthenticated := false
+	for attempt := 0; ; attempt++ {
+		var bodyReader io.Reader
+		if jsonBody != nil {
+			bodyReader = bytes.NewReader(jsonBody)
+		}
+
+		attemptCtx, cancel := c.requestContext(ctx)
+		req, err := http.NewRequestWithContext(attemptCtx, method, url, bodyReader)
 		if err != nil {
+			cancel()
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
-	} else {
-		req, err = http.NewRequestWithContext(ctx, method, url, nil)
+
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json")
+		if err := c.setAuthHeaders(ctx, req); err != nil {
+			cancel()
+			return nil, err
+		}
+
+		if c.LiteLLMChangedBy != "" {
+			req.Header.Set("litellm-changed-by", c.LiteLLMChangedBy)
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && c.Credentials != nil && !reauthenticated {
+			// The cached credential may have been revoked or rotated; fetch a
+			// fresh one and try once more.
+			reauthenticated = true
+			c.Credentials.Invalidate()
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			cancel()
+			attempt--
+			continue
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
+			cancel()
+			return nil, err
+		}
+		if !isRetryableStatus(method, path, resp.StatusCode) || attempt >= c.MaxRetries {
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		wait := c.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			wait = retryAfter
+			if c.RetryMaxWait > 0 && wait > c.RetryMaxWait {
+				wait = c.RetryMaxWait
+			}
+		}
+
+		// Drain the body so the underlying connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		cancel()
+
+		tflog.Debug(ctx, "Retrying LiteLLM API request after transient failure", map[string]interface{}{
+			"method":  method,
+			"path":    path,
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		})
+
+		if err := sleepWithContext(ctx, wait); err != nil {
+			return nil, err
 		}
 	}
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("x-api-key", c.APIKey)
-	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+// requestContext returns the context for a single HTTP attempt, bounded by the
+// client's RequestTimeout. An operation deadline (see withTimeout) caps it
+// further, so that one hung request cannot outlive the operation but also
+// cannot use up the time left for retries.
+func (c *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.RequestTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.RequestTimeout)
+}
+
+// cancelOnClose releases a request's context once its response body has been
+// read and closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
 
-	if c.LiteLLMChangedBy != "" {
-		req.Header.Set("litellm-changed-by", c.LiteLLMChangedBy)
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// setAuthHeaders authenticates req with the static API key, or with the
+// credential from the configured auth block when one is set.
+func (c *Client) setAuthHeaders(ctx context.Context, req *http.Request) error {
+	if c.Credentials == nil {
+		req.Header.Set("x-api-key", c.APIKey)
+		req.Header.Set("Authorization", "Bearer "+c.APIKey)
+		return nil
 	}
 
-	return c.HTTPClient.Do(req)
+	credential, err := c.Credentials.Credential(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to obtain credentials: %w", err)
+	}
+	if !c.Credentials.BearerOnly() {
+		req.Header.Set("x-api-key", credential)
+	}
+	req.Header.Set("Authorization", "Bearer "+credential)
+	return nil
 }
 
 // DoRequestWithResponse performs an HTTP request and decodes the JSON response.
@@ -59,7 +174,7 @@ func (c *Client) DoRequestWithResponse(ctx context.Context, method, path string,
 
 	// Handle non-2xx status codes
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return newAPIError(method, path, resp.StatusCode, bodyBytes)
 	}
 
 	// If no result expected, return early
@@ -79,26 +194,284 @@ func (c *Client) DoRequestWithResponse(ctx context.Context, method, path string,
 	return nil
 }
 
-// IsNotFoundError checks if the error message indicates a not found condition.
+// retryWhile calls fn up to attempts times, waiting between attempts using the
+// client's backoff policy for as long as shouldRetry reports true for the
+// returned error. It is used for eventual-consistency retries (e.g. reading
+// back an object that the proxy has not propagated yet), which are distinct
+// from the transient HTTP failures handled inside DoRequest. Retries stop
+// early when ctx is done.
+func (c *Client) retryWhile(ctx context.Context, attempts int, shouldRetry func(error) bool, fn func() error) error {
+	var err error
+	for i := 0; i < attempts; i++ {
+		err = fn()
+		if err == nil || !shouldRetry(err) {
+			return err
+		}
+
+		if i < attempts-1 {
+			if sleepErr := sleepWithContext(ctx, c.backoff(i)); sleepErr != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// readBackAttempts returns the number of attempts for reading back an object
+// right after creating it. Under an operation deadline, such as a create
+// timeout, the read is retried until the deadline so that slow propagation is
+// bounded by the timeout rather than by attempts. Refreshes and updates keep
+// the attempt cap, so that an object deleted outside Terraform is dropped
+// from state promptly.
+func readBackAttempts(ctx context.Context, attempts int) int {
+	if _, ok := ctx.Deadline(); ok {
+		return math.MaxInt
+	}
+	return attempts
+}
+
+// backoff returns the exponential wait before the given (zero-based) retry
+// attempt, bounded by RetryMinWait and RetryMaxWait.
+func (c *Client) backoff(attempt int) time.Duration {
+	wait := c.RetryMinWait
+	for i := 0; i < attempt && wait < c.RetryMaxWait; i++ {
+		wait *= 2
+	}
+	if c.RetryMaxWait > 0 && wait > c.RetryMaxWait {
+		wait = c.RetryMaxWait
+	}
+	return wait
+}
+
+// isRetryableStatus reports whether an HTTP status indicates a transient
+// failure worth retrying. 501 Not Implemented is permanent and excluded.
+//
+// A POST that creates an object, such as /key/generate or /team/new, may have
+// been committed before the proxy failed, and repeating it would create an
+// untracked duplicate. Such requests are only retried on 429 and 503, which
+// report that the request was not processed.
+func isRetryableStatus(method, path string, status int) bool {
+	if status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable {
+		return true
+	}
+	if status < 500 || status == http.StatusNotImplemented {
+		return false
+	}
+	return isIdempotentRequest(method, path)
+}
+
+// isIdempotentRequest reports whether repeating a request has the same effect
+// as making it once. LiteLLM updates and deletes objects through POST, so its
+// /update and /delete endpoints count as idempotent.
+func isIdempotentRequest(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		path, _, _ = strings.Cut(path, "?")
+		return strings.HasSuffix(path, "/update") || strings.HasSuffix(path, "/delete")
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header value, which may be either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleepWithContext waits for d or until ctx is done, whichever comes first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// APIError is returned by DoRequestWithResponse when the LiteLLM API responds
+// with a non-2xx status. The LiteLLM error body
+// {"error": {"message", "type", "param", "code"}} is decoded when present.
+type APIError struct {
+	StatusCode int
+	Method     string
+	// Path is the request path without its query string, so that values such
+	// as raw keys passed to /key/info never end up in diagnostics.
+	Path string
+
+	Message string
+	Type    string
+	Param   string
+	Code    string
+
+	// Body is the raw response body.
+	Body string
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = strings.TrimSpace(e.Body)
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	return fmt.Sprintf("%s %s failed with status %d: %s", e.Method, e.Path, e.StatusCode, msg)
+}
+
+// newAPIError builds an APIError from a failed response, decoding the LiteLLM
+// error envelope as well as FastAPI-style {"detail": ...} bodies.
+func newAPIError(method, requestPath string, status int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: status,
+		Method:     method,
+		Path:       requestPath,
+		Body:       string(body),
+	}
+	if i := strings.IndexByte(requestPath, '?'); i >= 0 {
+		apiErr.Path = requestPath[:i]
+	}
+
+	var envelope map[string]interface{}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return apiErr
+	}
+
+	fields := envelope
+	if nested, ok := envelope["error"].(map[string]interface{}); ok {
+		fields = nested
+	}
+	apiErr.Message = errorFieldString(fields["message"])
+	apiErr.Type = errorFieldString(fields["type"])
+	apiErr.Param = errorFieldString(fields["param"])
+	apiErr.Code = errorFieldString(fields["code"])
+
+	if apiErr.Message == "" {
+		if msg, ok := envelope["error"].(string); ok {
+			apiErr.Message = msg
+		}
+	}
+
+	// FastAPI returns {"detail": "..."}, {"detail": {"error": "..."}} or, for
+	// request validation failures, {"detail": [{"loc": [...], "msg": "..."}]}.
+	if apiErr.Message == "" {
+		switch detail := envelope["detail"].(type) {
+		case string:
+			apiErr.Message = detail
+		case map[string]interface{}:
+			apiErr.Message = errorFieldString(detail["error"])
+		case []interface{}:
+			if len(detail) > 0 {
+				if first, ok := detail[0].(map[string]interface{}); ok {
+					apiErr.Message = errorFieldString(first["msg"])
+					if loc, ok := first["loc"].([]interface{}); ok && len(loc) > 0 && apiErr.Param == "" {
+						apiErr.Param = errorFieldString(loc[len(loc)-1])
+					}
+				}
+			}
+		}
+	}
+
+	return apiErr
+}
+
+// errorFieldString renders a decoded JSON error field as a string.
+func errorFieldString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		b, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprintf("%v", val)
+		}
+		return string(b)
+	}
+}
+
+// errNotFound is wrapped by errors the provider raises itself when a lookup
+// succeeds at the HTTP level but the object is absent from the response.
+var errNotFound = errors.New("not found")
+
+// IsNotFoundError reports whether err means the requested object does not
+// exist: either the API answered 404 or the provider found no matching object
+// in an otherwise successful response.
 func IsNotFoundError(err error) bool {
 	if err == nil {
 		return false
 	}
-	errStr := err.Error()
-	return contains(errStr, "not found") ||
-		contains(errStr, "404") ||
-		contains(errStr, "does not exist")
+	if errors.Is(err, errNotFound) {
+		return true
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+	return false
 }
 
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsImpl(s, substr))
+// isAlreadyDeletedError reports whether a delete call failed only because the
+// object is already gone. Besides 404, several LiteLLM delete endpoints answer
+// 400 with a "not found" message for objects that no longer exist.
+func isAlreadyDeletedError(err error) bool {
+	if IsNotFoundError(err) {
+		return true
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		msg := strings.ToLower(apiErr.Message)
+		return strings.Contains(msg, "not found") || strings.Contains(msg, "does not exist")
+	}
+	return false
+}
+
+// schemaPathTyper is satisfied by the Schema carried on tfsdk.Plan, tfsdk.State
+// and tfsdk.Config, and is used to check whether an API error's param names an
+// attribute of the resource.
+type schemaPathTyper interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
 }
 
-func containsImpl(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
+// addClientError records a failed API call as a "Client Error" diagnostic with
+// the detail "<action>: <error>". When err is an *APIError whose param names a
+// top-level attribute of s, the diagnostic is attached to that attribute.
+func addClientError(ctx context.Context, diags *diag.Diagnostics, s schemaPathTyper, action string, err error) {
+	detail := fmt.Sprintf("%s: %s", action, err)
+
+	var apiErr *APIError
+	if s != nil && errors.As(err, &apiErr) && apiErr.Param != "" {
+		attrPath := path.Root(apiErr.Param)
+		if _, pathDiags := s.TypeAtPath(ctx, attrPath); !pathDiags.HasError() {
+			diags.AddAttributeError(attrPath, "Client Error", detail)
+			return
 		}
 	}
-	return false
+
+	diags.AddError("Client Error", detail)
 }
@@ -0,0 +1,365 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestDoRequest_retriesTransientFailures(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"ok": true})
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{
+		APIBase:      server.URL,
+		APIKey:       "test-key",
+		HTTPClient:   server.Client(),
+		MaxRetries:   3,
+		RetryMinWait: time.Millisecond,
+		RetryMaxWait: 5 * time.Millisecond,
+	}
+
+	var result map[string]interface{}
+	if err := client.DoRequestWithResponse(context.Background(), "POST", "/key/generate", map[string]string{"key_alias": "a"}, &result); err != nil {
+		t.Fatalf("DoRequestWithResponse: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("calls = %d, want 3", got)
+	}
+	if result["ok"] != true {
+		t.Errorf("result = %v, want ok=true", result)
+	}
+	for i, b := range bodies {
+		if b != `{"key_alias":"a"}` {
+			t.Errorf("attempt %d body = %q, want the original request body", i+1, b)
+		}
+	}
+}
+
+func TestDoRequest_stopsAfterMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		APIBase:      server.URL,
+		HTTPClient:   server.Client(),
+		MaxRetries:   2,
+		RetryMinWait: time.Millisecond,
+		RetryMaxWait: time.Millisecond,
+	}
+
+	err := client.DoRequestWithResponse(context.Background(), "GET", "/team/info", nil, nil)
+	if err == nil {
+		t.Fatal("expected error after retries were exhausted")
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("calls = %d, want 3 (1 attempt + 2 retries)", got)
+	}
+}
+
+func TestDoRequest_retriesServerErrorsOnlyWhenIdempotent(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method    string
+		path      string
+		wantCalls int32
+	}{
+		"create":      {method: "POST", path: "/key/generate", wantCalls: 1},
+		"update":      {method: "POST", path: "/team/update", wantCalls: 3},
+		"delete":      {method: "POST", path: "/key/delete", wantCalls: 3},
+		"read":        {method: "GET", path: "/team/info?team_id=team-1", wantCalls: 3},
+		"rest delete": {method: "DELETE", path: "/v1/agents/agent-1", wantCalls: 3},
+		"rest create": {method: "POST", path: "/v1/agents", wantCalls: 1},
+	}
+
+	for name, tt := range tests {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+
+		client := &Client{
+			APIBase:      server.URL,
+			HTTPClient:   server.Client(),
+			MaxRetries:   2,
+			RetryMinWait: time.Millisecond,
+			RetryMaxWait: time.Millisecond,
+		}
+		if err := client.DoRequestWithResponse(context.Background(), tt.method, tt.path, nil, nil); err == nil {
+			t.Errorf("%s: expected error for 502 response", name)
+		}
+		server.Close()
+
+		if got := atomic.LoadInt32(&calls); got != tt.wantCalls {
+			t.Errorf("%s: calls = %d, want %d", name, got, tt.wantCalls)
+		}
+	}
+}
+
+func TestDoRequest_doesNotRetryClientErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		APIBase:      server.URL,
+		HTTPClient:   server.Client(),
+		MaxRetries:   3,
+		RetryMinWait: time.Millisecond,
+		RetryMaxWait: time.Millisecond,
+	}
+
+	if err := client.DoRequestWithResponse(context.Background(), "GET", "/team/info", nil, nil); err == nil {
+		t.Fatal("expected error for 400 response")
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestDoRequest_appliesRequestTimeoutPerAttempt(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		APIBase:        server.URL,
+		HTTPClient:     server.Client(),
+		RequestTimeout: 20 * time.Millisecond,
+	}
+
+	if err := client.DoRequestWithResponse(context.Background(), "POST", "/v1/mcp/server", nil, nil); err == nil {
+		t.Fatal("expected the request to time out after RequestTimeout")
+	}
+
+	// A longer operation deadline does not extend a single request.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.DoRequestWithResponse(ctx, "POST", "/v1/mcp/server", nil, nil); err == nil {
+		t.Fatal("expected the request to time out after RequestTimeout under an operation deadline")
+	}
+}
+
+func TestRetryWhile_keepsAttemptCap(t *testing.T) {
+	t.Parallel()
+
+	client := &Client{RetryMinWait: time.Millisecond, RetryMaxWait: time.Millisecond}
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+
+	// A read timeout must not turn a deleted object into retries until the
+	// deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var calls int
+	err := client.retryWhile(ctx, 2, IsNotFoundError, func() error {
+		calls++
+		return notFound
+	})
+	if err == nil || calls != 2 {
+		t.Fatalf("with a deadline: calls = %d, err = %v; want 2 calls and an error", calls, err)
+	}
+
+	// Reading back a created object retries until the create deadline.
+	calls = 0
+	err = client.retryWhile(ctx, readBackAttempts(ctx, 2), IsNotFoundError, func() error {
+		calls++
+		if calls < 5 {
+			return notFound
+		}
+		return nil
+	})
+	if err != nil || calls != 5 {
+		t.Fatalf("read-back with a deadline: calls = %d, err = %v; want 5 calls and no error", calls, err)
+	}
+
+	if got := readBackAttempts(context.Background(), 2); got != 2 {
+		t.Errorf("readBackAttempts without a deadline = %d, want 2", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	if d, ok := parseRetryAfter("7"); !ok || d != 7*time.Second {
+		t.Errorf("parseRetryAfter(7) = %v, %v; want 7s, true", d, ok)
+	}
+	if _, ok := parseRetryAfter(""); ok {
+		t.Error("parseRetryAfter(\"\") should not be ok")
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("parseRetryAfter(soon) should not be ok")
+	}
+	future := time.Now().Add(1 * time.Hour).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(future); !ok || d <= 0 {
+		t.Errorf("parseRetryAfter(http-date) = %v, %v; want positive duration", d, ok)
+	}
+}
+
+func TestBackoff_isBoundedByMaxWait(t *testing.T) {
+	t.Parallel()
+
+	client := &Client{RetryMinWait: time.Second, RetryMaxWait: 5 * time.Second}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if got := client.backoff(i); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i, got, w)
+		}
+	}
+}
+
+func TestDoRequestWithResponse_returnsAPIError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"error": map[string]interface{}{
+				"message": "Invalid budget_duration",
+				"type":    "bad_request_error",
+				"param":   "budget_duration",
+				"code":    "400",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, HTTPClient: server.Client()}
+
+	err := client.DoRequestWithResponse(context.Background(), "GET", "/key/info?key=sk-secret", nil, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("error type = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("StatusCode = %d, want 400", apiErr.StatusCode)
+	}
+	if apiErr.Method != "GET" || apiErr.Path != "/key/info" {
+		t.Errorf("request = %s %s, want GET /key/info", apiErr.Method, apiErr.Path)
+	}
+	if apiErr.Message != "Invalid budget_duration" || apiErr.Type != "bad_request_error" || apiErr.Param != "budget_duration" || apiErr.Code != "400" {
+		t.Errorf("decoded error = %+v", apiErr)
+	}
+	if strings.Contains(err.Error(), "sk-secret") {
+		t.Errorf("error message leaks query string: %s", err)
+	}
+	if want := "GET /key/info failed with status 400: Invalid budget_duration"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestNewAPIError_decodesDetailBodies(t *testing.T) {
+	t.Parallel()
+
+	apiErr := newAPIError("POST", "/team/new", http.StatusUnprocessableEntity,
+		[]byte(`{"detail":[{"loc":["body","max_budget"],"msg":"Input should be a valid number"}]}`))
+	if apiErr.Message != "Input should be a valid number" || apiErr.Param != "max_budget" {
+		t.Errorf("validation detail decoded as %+v", apiErr)
+	}
+
+	apiErr = newAPIError("GET", "/team/info", http.StatusNotFound, []byte(`{"detail":{"error":"Team not found"}}`))
+	if apiErr.Message != "Team not found" {
+		t.Errorf("Message = %q, want Team not found", apiErr.Message)
+	}
+
+	apiErr = newAPIError("GET", "/team/info", http.StatusBadGateway, []byte(`<html>bad gateway</html>`))
+	if apiErr.Message != "" || !strings.Contains(apiErr.Error(), "<html>bad gateway</html>") {
+		t.Errorf("non-JSON body should be reported verbatim, got %q", apiErr.Error())
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	if !IsNotFoundError(&APIError{StatusCode: http.StatusNotFound}) {
+		t.Error("404 should be a not found error")
+	}
+	if !IsNotFoundError(fmt.Errorf("budget b-1: %w", errNotFound)) {
+		t.Error("wrapped errNotFound should be a not found error")
+	}
+	badRequest := &APIError{StatusCode: http.StatusBadRequest, Message: "model gpt-5 not found"}
+	if IsNotFoundError(badRequest) {
+		t.Error("400 mentioning a missing model must not be treated as not found")
+	}
+	if !isAlreadyDeletedError(badRequest) {
+		t.Error("400 with a not found message should be tolerated on delete")
+	}
+	if IsNotFoundError(errors.New("connection refused: 404 not found")) {
+		t.Error("plain errors should not be matched by substring")
+	}
+}
+
+func TestAddClientError_pointsAtParamAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&KeyResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	var diags diag.Diagnostics
+	addClientError(ctx, &diags, schemaResp.Schema, "Unable to create key",
+		&APIError{StatusCode: http.StatusBadRequest, Method: "POST", Path: "/key/generate", Message: "invalid", Param: "budget_duration"})
+	if len(diags) != 1 {
+		t.Fatalf("diagnostics = %d, want 1", len(diags))
+	}
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("budget_duration")) {
+		t.Errorf("diagnostic = %#v, want attribute error at budget_duration", diags[0])
+	}
+	if diags[0].Detail() != "Unable to create key: POST /key/generate failed with status 400: invalid" {
+		t.Errorf("Detail() = %q", diags[0].Detail())
+	}
+
+	diags = nil
+	addClientError(ctx, &diags, schemaResp.Schema, "Unable to create key",
+		&APIError{StatusCode: http.StatusBadRequest, Param: "not_an_attribute"})
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Error("unknown param should produce a plain error diagnostic")
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -121,28 +120,7 @@ func (d *CredentialDataSource) Read(ctx context.Context, req datasource.ReadRequ
 }
 
 func readCredentialDataSourceWithRetry(ctx context.Context, client *Client, endpoint string, result *map[string]interface{}, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		err = client.DoRequestWithResponse(ctx, "GET", endpoint, nil, result)
-		if err == nil {
-			return nil
-		}
-
-		if !IsNotFoundError(err) {
-			return err
-		}
-
-		if i < maxRetries-1 {
-			time.Sleep(delay)
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
-	return err
+	return client.retryWhile(ctx, maxRetries, IsNotFoundError, func() error {
+		return client.DoRequestWithResponse(ctx, "GET", endpoint, nil, result)
+	})
 }
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CustomerDataSource{}
+
+func NewCustomerDataSource() datasource.DataSource {
+	return &CustomerDataSource{}
+}
+
+type CustomerDataSource struct {
+	client *Client
+}
+
+type CustomerDataSourceModel struct {
+	ID                 types.String  `tfsdk:"id"`
+	UserID             types.String  `tfsdk:"user_id"`
+	Alias              types.String  `tfsdk:"alias"`
+	Blocked            types.Bool    `tfsdk:"blocked"`
+	AllowedModelRegion types.String  `tfsdk:"allowed_model_region"`
+	DefaultModel       types.String  `tfsdk:"default_model"`
+	BudgetID           types.String  `tfsdk:"budget_id"`
+	MaxBudget          types.Float64 `tfsdk:"max_budget"`
+	BudgetDuration     types.String  `tfsdk:"budget_duration"`
+	TPMLimit           types.Int64   `tfsdk:"tpm_limit"`
+	RPMLimit           types.Int64   `tfsdk:"rpm_limit"`
+	Spend              types.Float64 `tfsdk:"spend"`
+}
+
+func (d *CustomerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer"
+}
+
+func (d *CustomerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves information about a LiteLLM customer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for this customer.",
+				Computed:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The customer ID to look up.",
+				Required:    true,
+			},
+			"alias": schema.StringAttribute{
+				Description: "A descriptive name for the customer.",
+				Computed:    true,
+			},
+			"blocked": schema.BoolAttribute{
+				Description: "Whether requests for the customer are rejected.",
+				Computed:    true,
+			},
+			"allowed_model_region": schema.StringAttribute{
+				Description: "Region the customer's requests are restricted to.",
+				Computed:    true,
+			},
+			"default_model": schema.StringAttribute{
+				Description: "Model used for the customer's requests when no model is given.",
+				Computed:    true,
+			},
+			"budget_id": schema.StringAttribute{
+				Description: "ID of the customer's budget.",
+				Computed:    true,
+			},
+			"max_budget": schema.Float64Attribute{
+				Description: "Maximum budget for the customer.",
+				Computed:    true,
+			},
+			"budget_duration": schema.StringAttribute{
+				Description: "Budget reset duration.",
+				Computed:    true,
+			},
+			"tpm_limit": schema.Int64Attribute{
+				Description: "Tokens per minute limit for the customer.",
+				Computed:    true,
+			},
+			"rpm_limit": schema.Int64Attribute{
+				Description: "Requests per minute limit for the customer.",
+				Computed:    true,
+			},
+			"spend": schema.Float64Attribute{
+				Description: "Amount spent by this customer.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CustomerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomerDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueString()
+	info, err := d.client.getCustomer(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer '%s': %s", userID, err))
+		return
+	}
+
+	// Set ID
+	data.ID = data.UserID
+
+	item := customerListItemFromAPI(info)
+	data.Alias = item.Alias
+	data.Blocked = item.Blocked
+	data.AllowedModelRegion = item.AllowedModelRegion
+	data.DefaultModel = item.DefaultModel
+	data.BudgetID = item.BudgetID
+	data.MaxBudget = item.MaxBudget
+	data.Spend = item.Spend
+
+	// Budget fields live on the customer's budget
+	budgetTable, _ := info["litellm_budget_table"].(map[string]interface{})
+	if budgetDuration, ok := budgetTable["budget_duration"].(string); ok {
+		data.BudgetDuration = types.StringValue(budgetDuration)
+	}
+	if tpmLimit, ok := budgetTable["tpm_limit"].(float64); ok {
+		data.TPMLimit = types.Int64Value(int64(tpmLimit))
+	}
+	if rpmLimit, ok := budgetTable["rpm_limit"].(float64); ok {
+		data.RPMLimit = types.Int64Value(int64(rpmLimit))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
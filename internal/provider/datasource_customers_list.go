@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CustomersListDataSource{}
+
+func NewCustomersListDataSource() datasource.DataSource {
+	return &CustomersListDataSource{}
+}
+
+type CustomersListDataSource struct {
+	client *Client
+}
+
+type CustomerListItem struct {
+	UserID             types.String  `tfsdk:"user_id"`
+	Alias              types.String  `tfsdk:"alias"`
+	Blocked            types.Bool    `tfsdk:"blocked"`
+	AllowedModelRegion types.String  `tfsdk:"allowed_model_region"`
+	DefaultModel       types.String  `tfsdk:"default_model"`
+	BudgetID           types.String  `tfsdk:"budget_id"`
+	MaxBudget          types.Float64 `tfsdk:"max_budget"`
+	Spend              types.Float64 `tfsdk:"spend"`
+}
+
+type CustomersListDataSourceModel struct {
+	ID        types.String       `tfsdk:"id"`
+	Customers []CustomerListItem `tfsdk:"customers"`
+}
+
+func (d *CustomersListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customers"
+}
+
+func (d *CustomersListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a list of LiteLLM customers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"customers": schema.ListNestedAttribute{
+				Description: "List of customers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The unique identifier for this customer.",
+							Computed:    true,
+						},
+						"alias": schema.StringAttribute{
+							Description: "A descriptive name for the customer.",
+							Computed:    true,
+						},
+						"blocked": schema.BoolAttribute{
+							Description: "Whether requests for the customer are rejected.",
+							Computed:    true,
+						},
+						"allowed_model_region": schema.StringAttribute{
+							Description: "Region the customer's requests are restricted to.",
+							Computed:    true,
+						},
+						"default_model": schema.StringAttribute{
+							Description: "Model used for the customer's requests when no model is given.",
+							Computed:    true,
+						},
+						"budget_id": schema.StringAttribute{
+							Description: "ID of the customer's budget.",
+							Computed:    true,
+						},
+						"max_budget": schema.Float64Attribute{
+							Description: "Maximum budget for the customer.",
+							Computed:    true,
+						},
+						"spend": schema.Float64Attribute{
+							Description: "Amount spent by this customer.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomersListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CustomersListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomersListDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", "/customer/list", nil, &result); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list customers: %s", err))
+		return
+	}
+
+	// Set placeholder ID
+	data.ID = types.StringValue("customers")
+
+	// The endpoint returns a bare array; wrapped responses are accepted too.
+	customersData, _ := result.([]interface{})
+	if wrapped, ok := result.(map[string]interface{}); ok {
+		if customers, ok := wrapped["customers"].([]interface{}); ok {
+			customersData = customers
+		} else if dataArr, ok := wrapped["data"].([]interface{}); ok {
+			customersData = dataArr
+		}
+	}
+
+	data.Customers = make([]CustomerListItem, 0, len(customersData))
+	for _, c := range customersData {
+		customerMap, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		data.Customers = append(data.Customers, customerListItemFromAPI(customerMap))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// customerListItemFromAPI converts a LiteLLM_EndUserTable record into a list
+// item. Unset fields are null.
+func customerListItemFromAPI(customerMap map[string]interface{}) CustomerListItem {
+	item := CustomerListItem{}
+
+	if userID, ok := customerMap["user_id"].(string); ok {
+		item.UserID = types.StringValue(userID)
+	}
+	if alias, ok := customerMap["alias"].(string); ok {
+		item.Alias = types.StringValue(alias)
+	}
+	if blocked, ok := customerMap["blocked"].(bool); ok {
+		item.Blocked = types.BoolValue(blocked)
+	}
+	if region, ok := customerMap["allowed_model_region"].(string); ok {
+		item.AllowedModelRegion = types.StringValue(region)
+	}
+	if defaultModel, ok := customerMap["default_model"].(string); ok {
+		item.DefaultModel = types.StringValue(defaultModel)
+	}
+	if spend, ok := customerMap["spend"].(float64); ok {
+		item.Spend = types.Float64Value(spend)
+	}
+
+	// Budget fields live on the customer's budget
+	budgetTable, _ := customerMap["litellm_budget_table"].(map[string]interface{})
+	if budgetID, ok := budgetTable["budget_id"].(string); ok {
+		item.BudgetID = types.StringValue(budgetID)
+	}
+	if maxBudget, ok := budgetTable["max_budget"].(float64); ok {
+		item.MaxBudget = types.Float64Value(maxBudget)
+	}
+
+	return item
+}
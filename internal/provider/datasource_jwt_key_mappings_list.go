@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &JWTKeyMappingsListDataSource{}
+
+func NewJWTKeyMappingsListDataSource() datasource.DataSource {
+	return &JWTKeyMappingsListDataSource{}
+}
+
+type JWTKeyMappingsListDataSource struct {
+	client *Client
+}
+
+type JWTKeyMappingListItem struct {
+	ID            types.String `tfsdk:"id"`
+	JWTClaimName  types.String `tfsdk:"jwt_claim_name"`
+	JWTClaimValue types.String `tfsdk:"jwt_claim_value"`
+	Description   types.String `tfsdk:"description"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+type JWTKeyMappingsListDataSourceModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Mappings []JWTKeyMappingListItem `tfsdk:"mappings"`
+}
+
+func (d *JWTKeyMappingsListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_key_mappings"
+}
+
+func (d *JWTKeyMappingsListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a list of LiteLLM JWT key mappings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier.",
+				Computed:    true,
+			},
+			"mappings": schema.ListNestedAttribute{
+				Description: "List of JWT key mappings. The mapped keys are not returned by LiteLLM.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier for this mapping.",
+							Computed:    true,
+						},
+						"jwt_claim_name": schema.StringAttribute{
+							Description: "Name of the JWT claim matched by the mapping.",
+							Computed:    true,
+						},
+						"jwt_claim_value": schema.StringAttribute{
+							Description: "Value of the JWT claim that selects the mapped key.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the mapping.",
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the mapping is used to authenticate requests.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the mapping was created.",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Timestamp when the mapping was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JWTKeyMappingsListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JWTKeyMappingsListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JWTKeyMappingsListDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set placeholder ID
+	data.ID = types.StringValue("jwt_key_mappings")
+
+	data.Mappings = []JWTKeyMappingListItem{}
+	for page := 1; ; page++ {
+		mappings, totalPages, err := d.listMappings(ctx, page)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list JWT key mappings: %s", err))
+			return
+		}
+		for _, m := range mappings {
+			data.Mappings = append(data.Mappings, jwtKeyMappingListItemFromAPI(m))
+		}
+		if page >= totalPages || len(mappings) == 0 {
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listMappings fetches one page of mappings and the total number of pages.
+// Responses without pagination details are treated as a single page.
+func (d *JWTKeyMappingsListDataSource) listMappings(ctx context.Context, page int) ([]map[string]interface{}, int, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("size", strconv.Itoa(listPageSize))
+
+	var result interface{}
+	if err := d.client.DoRequestWithResponse(ctx, "GET", "/jwt/key/mapping/list?"+params.Encode(), nil, &result); err != nil {
+		return nil, 0, err
+	}
+
+	totalPages := 1
+	mappingsData, _ := result.([]interface{})
+	if wrapped, ok := result.(map[string]interface{}); ok {
+		for _, field := range []string{"mappings", "data", "items"} {
+			if arr, ok := wrapped[field].([]interface{}); ok {
+				mappingsData = arr
+				break
+			}
+		}
+		if v, ok := wrapped["total_pages"].(float64); ok {
+			totalPages = int(v)
+		}
+	}
+
+	mappings := make([]map[string]interface{}, 0, len(mappingsData))
+	for _, m := range mappingsData {
+		if mappingMap, ok := m.(map[string]interface{}); ok {
+			mappings = append(mappings, mappingMap)
+		}
+	}
+	return mappings, totalPages, nil
+}
+
+// jwtKeyMappingListItemFromAPI converts a JWTKeyMappingResponse into a list
+// item. Unset fields are null.
+func jwtKeyMappingListItemFromAPI(mappingMap map[string]interface{}) JWTKeyMappingListItem {
+	item := JWTKeyMappingListItem{
+		ID:            types.StringNull(),
+		JWTClaimName:  types.StringNull(),
+		JWTClaimValue: types.StringNull(),
+		Description:   types.StringNull(),
+		IsActive:      types.BoolNull(),
+		CreatedAt:     types.StringNull(),
+		UpdatedAt:     types.StringNull(),
+	}
+
+	if id, ok := mappingMap["id"].(string); ok {
+		item.ID = types.StringValue(id)
+	}
+	if claimName, ok := mappingMap["jwt_claim_name"].(string); ok {
+		item.JWTClaimName = types.StringValue(claimName)
+	}
+	if claimValue, ok := mappingMap["jwt_claim_value"].(string); ok {
+		item.JWTClaimValue = types.StringValue(claimValue)
+	}
+	if description, ok := mappingMap["description"].(string); ok {
+		item.Description = types.StringValue(description)
+	}
+	if isActive, ok := mappingMap["is_active"].(bool); ok {
+		item.IsActive = types.BoolValue(isActive)
+	}
+	if createdAt, ok := mappingMap["created_at"].(string); ok {
+		item.CreatedAt = types.StringValue(createdAt)
+	}
+	if updatedAt, ok := mappingMap["updated_at"].(string); ok {
+		item.UpdatedAt = types.StringValue(updatedAt)
+	}
+
+	return item
+}
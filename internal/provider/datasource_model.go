@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -209,28 +208,7 @@ func parseModelInfoResult(rawResult map[string]interface{}) map[string]interface
 }
 
 func readModelDataSourceWithRetry(ctx context.Context, client *Client, endpoint string, result *map[string]interface{}, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		err = client.DoRequestWithResponse(ctx, "GET", endpoint, nil, result)
-		if err == nil {
-			return nil
-		}
-
-		if !IsNotFoundError(err) {
-			return err
-		}
-
-		if i < maxRetries-1 {
-			time.Sleep(delay)
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
-	return err
+	return client.retryWhile(ctx, maxRetries, IsNotFoundError, func() error {
+		return client.DoRequestWithResponse(ctx, "GET", endpoint, nil, result)
+	})
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -107,7 +106,7 @@ func (d *PromptDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	promptID := data.PromptID.ValueString()
-	endpoint := fmt.Sprintf("/prompts/%s", promptID)
+	endpoint := promptInfoEndpoint(ctx, d.client, promptID)
 
 	var rawResult map[string]interface{}
 	if err := readPromptDataSourceWithRetry(ctx, d.client, endpoint, &rawResult, 8); err != nil {
@@ -154,28 +153,7 @@ func (d *PromptDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func readPromptDataSourceWithRetry(ctx context.Context, client *Client, endpoint string, result *map[string]interface{}, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		err = client.DoRequestWithResponse(ctx, "GET", endpoint, nil, result)
-		if err == nil {
-			return nil
-		}
-
-		if !IsNotFoundError(err) {
-			return err
-		}
-
-		if i < maxRetries-1 {
-			time.Sleep(delay)
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
-	return err
+	return client.retryWhile(ctx, maxRetries, IsNotFoundError, func() error {
+		return client.DoRequestWithResponse(ctx, "GET", endpoint, nil, result)
+	})
 }
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServerInfoDataSource{}
+
+func NewServerInfoDataSource() datasource.DataSource {
+	return &ServerInfoDataSource{}
+}
+
+type ServerInfoDataSource struct {
+	client *Client
+}
+
+type ServerInfoDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Version          types.String `tfsdk:"version"`
+	Status           types.String `tfsdk:"status"`
+	DB               types.String `tfsdk:"db"`
+	Cache            types.String `tfsdk:"cache"`
+	SuccessCallbacks types.List   `tfsdk:"success_callbacks"`
+	Capabilities     types.Map    `tfsdk:"capabilities"`
+}
+
+func (d *ServerInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+func (d *ServerInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the version and readiness of the connected LiteLLM proxy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The API base URL of the connected proxy.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "LiteLLM version reported by the proxy. Empty when the proxy does not report one.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Readiness status of the proxy.",
+				Computed:    true,
+			},
+			"db": schema.StringAttribute{
+				Description: "Database connection status.",
+				Computed:    true,
+			},
+			"cache": schema.StringAttribute{
+				Description: "Configured cache type, if any.",
+				Computed:    true,
+			},
+			"success_callbacks": schema.ListAttribute{
+				Description: "Success callbacks configured on the proxy.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"capabilities": schema.MapAttribute{
+				Description: "Version-dependent features the provider uses, and whether the connected proxy supports them.",
+				Computed:    true,
+				ElementType: types.BoolType,
+			},
+		},
+	}
+}
+
+func (d *ServerInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServerInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServerInfoDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use the information the provider already detected, and only query the
+	// proxy again when detection failed.
+	info := d.client.serverInfo(ctx)
+	if info == nil {
+		var err error
+		info, err = d.client.fetchServerInfo(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server info: %s", err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(d.client.APIBase)
+	data.Version = types.StringValue(info.Version)
+	data.Status = types.StringValue(info.Status)
+	data.DB = types.StringValue(info.DB)
+	data.Cache = types.StringValue(info.Cache)
+
+	callbacks := make([]attr.Value, 0, len(info.SuccessCallbacks))
+	for _, cb := range info.SuccessCallbacks {
+		callbacks = append(callbacks, types.StringValue(cb))
+	}
+	data.SuccessCallbacks, _ = types.ListValue(types.StringType, callbacks)
+
+	capabilities := make(map[string]attr.Value, len(serverCapabilities))
+	for _, cap := range serverCapabilities {
+		capabilities[cap.Name] = types.BoolValue(info.supports(cap))
+	}
+	data.Capabilities, _ = types.MapValue(types.BoolType, capabilities)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// litellmDurationPattern matches the duration strings LiteLLM accepts for
+// budget_duration, duration and similar fields: a positive integer followed
+// by s, m, h, d, w or mo.
+var litellmDurationPattern = regexp.MustCompile(`^(\d+)(s|m|h|d|w|mo)$`)
+
+// litellmMonth is the length used for "mo" durations. LiteLLM resets monthly
+// budgets on calendar boundaries, so this is an approximation.
+const litellmMonth = 30 * 24 * time.Hour
+
+var litellmDurationUnits = map[string]time.Duration{
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+	"mo": litellmMonth,
+}
+
+// parseLiteLLMDuration parses a LiteLLM duration string such as "30d" or
+// "1h".
+func parseLiteLLMDuration(s string) (time.Duration, error) {
+	m := litellmDurationPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("invalid duration %q: expected a number followed by s, m, h, d, w or mo (e.g. \"30d\")", s)
+	}
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %s", s, err)
+	}
+	unit := litellmDurationUnits[m[2]]
+	if n > math.MaxInt64/int64(unit) {
+		return 0, fmt.Errorf("invalid duration %q: value out of range", s)
+	}
+	return time.Duration(n) * unit, nil
+}
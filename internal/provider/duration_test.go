@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLiteLLMDuration(t *testing.T) {
+	t.Parallel()
+
+	valid := map[string]time.Duration{
+		"30s": 30 * time.Second,
+		"15m": 15 * time.Minute,
+		"1h":  time.Hour,
+		"30d": 30 * 24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+		"1mo": 30 * 24 * time.Hour,
+	}
+	for input, want := range valid {
+		got, err := parseLiteLLMDuration(input)
+		if err != nil {
+			t.Errorf("parseLiteLLMDuration(%q) returned error: %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("parseLiteLLMDuration(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"", "30", "d", "1.5h", "-1d", "1y", "30 d", "1H", "9223372036854775807d", "106752d"} {
+		if _, err := parseLiteLLMDuration(input); err == nil {
+			t.Errorf("parseLiteLLMDuration(%q) should fail", input)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &CredentialEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CredentialEphemeralResource{}
+
+func NewCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &CredentialEphemeralResource{}
+}
+
+// CredentialEphemeralResource reads a credential stored in LiteLLM, including
+// its values, for the current Terraform run only.
+type CredentialEphemeralResource struct {
+	client *Client
+}
+
+type CredentialEphemeralResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	CredentialName   types.String `tfsdk:"credential_name"`
+	ModelID          types.String `tfsdk:"model_id"`
+	CredentialInfo   types.Map    `tfsdk:"credential_info"`
+	CredentialValues types.Map    `tfsdk:"credential_values"`
+}
+
+func (e *CredentialEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+func (e *CredentialEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a LiteLLM credential, including its values, for the current Terraform run. Nothing is stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for this credential (same as credential_name).",
+				Computed:    true,
+			},
+			"credential_name": schema.StringAttribute{
+				Description: "Name of the credential to read.",
+				Required:    true,
+			},
+			"model_id": schema.StringAttribute{
+				Description: "Model ID associated with this credential.",
+				Optional:    true,
+			},
+			"credential_info": schema.MapAttribute{
+				Description: "Additional information about the credential.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"credential_values": schema.MapAttribute{
+				Description: "Credential values as returned by LiteLLM.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (e *CredentialEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+func (e *CredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data CredentialEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := e.readCredential(ctx, &data); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Config.Schema, fmt.Sprintf("Unable to read credential '%s'", data.CredentialName.ValueString()), err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// readCredential reads the credential through the resource's retrying read
+// and adds the credential values from the API response.
+func (e *CredentialEphemeralResource) readCredential(ctx context.Context, data *CredentialEphemeralResourceModel) error {
+	credData := &CredentialResourceModel{
+		CredentialName: data.CredentialName,
+		ModelID:        data.ModelID,
+		CredentialInfo: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+	}
+
+	result, err := (&CredentialResource{client: e.client}).fetchCredentialWithRetry(ctx, credData, 8)
+	if err != nil {
+		return err
+	}
+
+	data.ID = credData.ID
+	data.CredentialName = credData.CredentialName
+	data.CredentialInfo = credData.CredentialInfo
+
+	values := make(map[string]attr.Value)
+	if credValues, ok := result["credential_values"].(map[string]interface{}); ok {
+		for k, v := range credValues {
+			if v == nil {
+				continue
+			}
+			values[k] = types.StringValue(metadataValueToString(v))
+		}
+	}
+	data.CredentialValues, _ = types.MapValue(types.StringType, values)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEphemeralCredentialReadsValuesWithRetry(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/credentials/by_name/openai" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		// The first lookup misses, as right after the credential was created.
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"Credential not found"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"credential_name": "openai",
+			"credential_info": map[string]interface{}{"provider": "openai"},
+			"credential_values": map[string]interface{}{
+				"api_key":     "sk-stored",
+				"max_retries": 3,
+			},
+		})
+	}))
+	defer server.Close()
+
+	e := &CredentialEphemeralResource{
+		client: &Client{
+			APIBase:    server.URL,
+			APIKey:     "test-key",
+			HTTPClient: server.Client(),
+		},
+	}
+
+	data := &CredentialEphemeralResourceModel{
+		CredentialName: types.StringValue("openai"),
+		ModelID:        types.StringNull(),
+	}
+	if err := e.readCredential(context.Background(), data); err != nil {
+		t.Fatalf("readCredential returned error: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected the not-found read to be retried, got %d calls", got)
+	}
+	if data.ID.ValueString() != "openai" {
+		t.Errorf("expected id=openai, got %q", data.ID.ValueString())
+	}
+
+	var values map[string]string
+	data.CredentialValues.ElementsAs(context.Background(), &values, false)
+	if values["api_key"] != "sk-stored" || values["max_retries"] != "3" {
+		t.Errorf("unexpected credential_values: %v", values)
+	}
+
+	var info map[string]string
+	data.CredentialInfo.ElementsAs(context.Background(), &info, false)
+	if info["provider"] != "openai" {
+		t.Errorf("unexpected credential_info: %v", info)
+	}
+}
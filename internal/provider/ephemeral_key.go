@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResource = &KeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &KeyEphemeralResource{}
+
+// ephemeralKeyPrivateKey is the private data key under which Open hands the
+// generated key to Close.
+const ephemeralKeyPrivateKey = "key"
+
+func NewKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyEphemeralResource{}
+}
+
+// KeyEphemeralResource generates a short-lived API key for the duration of a
+// Terraform run and deletes it again when Terraform closes the resource. The
+// key is never written to the plan or state.
+type KeyEphemeralResource struct {
+	client *Client
+}
+
+type KeyEphemeralResourceModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Key                 types.String  `tfsdk:"key"`
+	Expires             types.String  `tfsdk:"expires"`
+	Duration            types.String  `tfsdk:"duration"`
+	KeyAlias            types.String  `tfsdk:"key_alias"`
+	Models              types.List    `tfsdk:"models"`
+	UserID              types.String  `tfsdk:"user_id"`
+	TeamID              types.String  `tfsdk:"team_id"`
+	OrganizationID      types.String  `tfsdk:"organization_id"`
+	ProjectID           types.String  `tfsdk:"project_id"`
+	BudgetID            types.String  `tfsdk:"budget_id"`
+	MaxBudget           types.Float64 `tfsdk:"max_budget"`
+	SoftBudget          types.Float64 `tfsdk:"soft_budget"`
+	BudgetDuration      types.String  `tfsdk:"budget_duration"`
+	MaxParallelRequests types.Int64   `tfsdk:"max_parallel_requests"`
+	TPMLimit            types.Int64   `tfsdk:"tpm_limit"`
+	RPMLimit            types.Int64   `tfsdk:"rpm_limit"`
+	AllowedRoutes       types.List    `tfsdk:"allowed_routes"`
+	Guardrails          types.List    `tfsdk:"guardrails"`
+	Metadata            types.Map     `tfsdk:"metadata"`
+	Tags                types.List    `tfsdk:"tags"`
+}
+
+func (e *KeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+func (e *KeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a short-lived LiteLLM API key for the current Terraform run. The key is deleted when Terraform closes the ephemeral resource and is never stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Non-sensitive identifier for the key (SHA256 hash of the key value).",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "The generated API key value.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires": schema.StringAttribute{
+				Description: "Expiry timestamp reported by LiteLLM.",
+				Computed:    true,
+			},
+			"duration": schema.StringAttribute{
+				Description: "Key validity duration (e.g., '30m', '1h'). The key also expires on its own if Terraform cannot delete it.",
+				Required:    true,
+			},
+			"key_alias": schema.StringAttribute{
+				Description: "User-friendly alias for the key.",
+				Optional:    true,
+			},
+			"models": schema.ListAttribute{
+				Description: "List of models this key can access.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "User ID associated with this key.",
+				Optional:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Team ID associated with this key.",
+				Optional:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Organization ID associated with this key.",
+				Optional:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Project ID associated with this key.",
+				Optional:    true,
+			},
+			"budget_id": schema.StringAttribute{
+				Description: "Budget ID to associate with this key.",
+				Optional:    true,
+			},
+			"max_budget": schema.Float64Attribute{
+				Description: "Maximum budget for this key.",
+				Optional:    true,
+			},
+			"soft_budget": schema.Float64Attribute{
+				Description: "Soft budget limit for warnings.",
+				Optional:    true,
+			},
+			"budget_duration": schema.StringAttribute{
+				Description: "Budget reset duration (e.g., '30d', '1h').",
+				Optional:    true,
+			},
+			"max_parallel_requests": schema.Int64Attribute{
+				Description: "Maximum parallel requests allowed.",
+				Optional:    true,
+			},
+			"tpm_limit": schema.Int64Attribute{
+				Description: "Tokens per minute limit.",
+				Optional:    true,
+			},
+			"rpm_limit": schema.Int64Attribute{
+				Description: "Requests per minute limit.",
+				Optional:    true,
+			},
+			"allowed_routes": schema.ListAttribute{
+				Description: "List of allowed API routes.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"guardrails": schema.ListAttribute{
+				Description: "Guardrails for the key.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Metadata for the key.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags for the key.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (e *KeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+func (e *KeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data KeyEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := e.generateKey(ctx, &data); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Config.Schema, "Unable to generate key", err)
+		return
+	}
+
+	private, err := json.Marshal(map[string]string{"key": data.Key.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode private data: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralKeyPrivateKey, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *KeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, ephemeralKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var stored map[string]string
+	if err := json.Unmarshal(private, &stored); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode private data: %s", err))
+		return
+	}
+
+	if err := e.deleteKey(ctx, stored["key"]); err != nil {
+		addClientError(ctx, &resp.Diagnostics, nil, "Unable to delete ephemeral key", err)
+	}
+}
+
+// generateKey creates the key through the same request builder as the
+// litellm_key resource and records the generated value on data.
+func (e *KeyEphemeralResource) generateKey(ctx context.Context, data *KeyEphemeralResourceModel) error {
+	keyData := &KeyResourceModel{
+		Duration:            data.Duration,
+		KeyAlias:            data.KeyAlias,
+		Models:              data.Models,
+		UserID:              data.UserID,
+		TeamID:              data.TeamID,
+		OrganizationID:      data.OrganizationID,
+		ProjectID:           data.ProjectID,
+		BudgetID:            data.BudgetID,
+		MaxBudget:           data.MaxBudget,
+		SoftBudget:          data.SoftBudget,
+		BudgetDuration:      data.BudgetDuration,
+		MaxParallelRequests: data.MaxParallelRequests,
+		TPMLimit:            data.TPMLimit,
+		RPMLimit:            data.RPMLimit,
+		AllowedRoutes:       data.AllowedRoutes,
+		Guardrails:          data.Guardrails,
+		Metadata:            data.Metadata,
+		Tags:                data.Tags,
+	}
+	keyReq := (&KeyResource{client: e.client}).buildKeyRequest(ctx, keyData)
+
+	var result map[string]interface{}
+	if err := e.client.DoRequestWithResponse(ctx, "POST", "/key/generate", keyReq, &result); err != nil {
+		return err
+	}
+
+	keyVal, ok := result["key"].(string)
+	if !ok || keyVal == "" {
+		return fmt.Errorf("LiteLLM did not return a key")
+	}
+	data.Key = types.StringValue(keyVal)
+	data.ID = types.StringValue(hashKeyForID(keyVal))
+	data.Expires = types.StringNull()
+	if expires, ok := result["expires"].(string); ok && expires != "" {
+		data.Expires = types.StringValue(expires)
+	}
+
+	tflog.Debug(ctx, "Generated ephemeral key", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	return nil
+}
+
+// deleteKey deletes a key generated by Open. A key that has already expired
+// or been deleted is not an error.
+func (e *KeyEphemeralResource) deleteKey(ctx context.Context, key string) error {
+	if key == "" {
+		return nil
+	}
+	deleteReq := map[string]interface{}{
+		"keys": []string{key},
+	}
+	if err := e.client.DoRequestWithResponse(ctx, "POST", "/key/delete", deleteReq, nil); err != nil && !isAlreadyDeletedError(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEphemeralKeyGenerateAndDelete(t *testing.T) {
+	t.Parallel()
+
+	var generateBody, deleteBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/key/generate":
+			_ = json.NewDecoder(r.Body).Decode(&generateBody)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"key":     "sk-ephemeral",
+				"expires": "2026-01-01T01:00:00Z",
+			})
+		case "/key/delete":
+			_ = json.NewDecoder(r.Body).Decode(&deleteBody)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"deleted_keys": []string{"sk-ephemeral"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	e := &KeyEphemeralResource{
+		client: &Client{
+			APIBase:    server.URL,
+			APIKey:     "test-key",
+			HTTPClient: server.Client(),
+		},
+	}
+
+	models, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"gpt-4o-mini"})
+	data := &KeyEphemeralResourceModel{
+		Duration: types.StringValue("1h"),
+		KeyAlias: types.StringValue("ci-run"),
+		Models:   models,
+	}
+
+	if err := e.generateKey(context.Background(), data); err != nil {
+		t.Fatalf("generateKey returned error: %v", err)
+	}
+
+	if generateBody["duration"] != "1h" {
+		t.Errorf("expected duration=1h in request, got %v", generateBody["duration"])
+	}
+	if generateBody["key_alias"] != "ci-run" {
+		t.Errorf("expected key_alias=ci-run in request, got %v", generateBody["key_alias"])
+	}
+	if data.Key.ValueString() != "sk-ephemeral" {
+		t.Errorf("expected key sk-ephemeral, got %q", data.Key.ValueString())
+	}
+	if data.ID.ValueString() != hashKeyForID("sk-ephemeral") {
+		t.Errorf("expected hashed id, got %q", data.ID.ValueString())
+	}
+	if data.Expires.ValueString() != "2026-01-01T01:00:00Z" {
+		t.Errorf("expected expires to be recorded, got %q", data.Expires.ValueString())
+	}
+
+	if err := e.deleteKey(context.Background(), data.Key.ValueString()); err != nil {
+		t.Fatalf("deleteKey returned error: %v", err)
+	}
+	keys, _ := deleteBody["keys"].([]interface{})
+	if len(keys) != 1 || keys[0] != "sk-ephemeral" {
+		t.Errorf("expected /key/delete for sk-ephemeral, got %v", deleteBody)
+	}
+}
+
+func TestEphemeralKeyDeleteIgnoresMissingKey(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"message":"key not found"}}`))
+	}))
+	defer server.Close()
+
+	e := &KeyEphemeralResource{
+		client: &Client{
+			APIBase:    server.URL,
+			APIKey:     "test-key",
+			HTTPClient: server.Client(),
+		},
+	}
+
+	if err := e.deleteKey(context.Background(), "sk-expired"); err != nil {
+		t.Fatalf("deleteKey should ignore keys that no longer exist, got: %v", err)
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &CostPerTokenToPerMillionFunction{}
+
+func NewCostPerTokenToPerMillionFunction() function.Function {
+	return &CostPerTokenToPerMillionFunction{}
+}
+
+// CostPerTokenToPerMillionFunction converts a per-token cost, as reported by
+// LiteLLM, to the per-million-token cost litellm_model is configured with.
+type CostPerTokenToPerMillionFunction struct{}
+
+func (f *CostPerTokenToPerMillionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cost_per_token_to_per_million"
+}
+
+func (f *CostPerTokenToPerMillionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a per-token cost to a per-million-token cost.",
+		Description: "Multiplies a per-token cost (such as input_cost_per_token) by one million, giving the value for litellm_model's input_cost_per_million_tokens and output_cost_per_million_tokens. The provider divides those by one million again when sending them to LiteLLM.",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:        "cost_per_token",
+				Description: "Cost per token.",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f *CostPerTokenToPerMillionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var costPerToken *big.Float
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &costPerToken))
+	if resp.Error != nil {
+		return
+	}
+
+	perMillion := new(big.Float).SetPrec(costPerToken.Prec()).Mul(costPerToken, big.NewFloat(1000000))
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, perMillion))
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ModelRouteFunction{}
+
+func NewModelRouteFunction() function.Function {
+	return &ModelRouteFunction{}
+}
+
+// ModelRouteFunction builds the litellm_params.model route litellm_model
+// sends for a provider and base model.
+type ModelRouteFunction struct{}
+
+func (f *ModelRouteFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "model_route"
+}
+
+func (f *ModelRouteFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a LiteLLM model route from a provider and base model.",
+		Description: "Returns the \"provider/model\" route that litellm_model sends as litellm_params.model for the given custom_llm_provider and base_model.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "custom_llm_provider",
+				Description: "LiteLLM provider, e.g. \"openai\".",
+			},
+			function.StringParameter{
+				Name:        "base_model",
+				Description: "Model name at the provider, e.g. \"gpt-4o\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ModelRouteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var customLLMProvider, baseModel string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &customLLMProvider, &baseModel))
+	if resp.Error != nil {
+		return
+	}
+
+	if customLLMProvider == "" {
+		resp.Error = function.NewArgumentFuncError(0, "custom_llm_provider must not be empty")
+		return
+	}
+	if baseModel == "" {
+		resp.Error = function.NewArgumentFuncError(1, "base_model must not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, modelRoute(customLLMProvider, baseModel)))
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &NormalizeNumericFunction{}
+
+func NewNormalizeNumericFunction() function.Function {
+	return &NormalizeNumericFunction{}
+}
+
+// NormalizeNumericFunction rewrites a numeric string in the canonical form
+// litellm_model stores additional_litellm_params in.
+type NormalizeNumericFunction struct{}
+
+func (f *NormalizeNumericFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_numeric"
+}
+
+func (f *NormalizeNumericFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize a numeric string to plain decimal notation.",
+		Description: "Rewrites numbers in scientific notation such as \"2.5e-06\" as plain decimals (\"0.0000025\"), the form litellm_model stores additional_litellm_params values in. Integers and non-numeric strings are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "Value to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeNumericFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalizeNumericString(value)))
+}
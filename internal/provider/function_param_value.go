@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParamValueFunction{}
+
+func NewParamValueFunction() function.Function {
+	return &ParamValueFunction{}
+}
+
+// ParamValueFunction returns the value litellm_model sends to LiteLLM for an
+// additional_litellm_params entry.
+type ParamValueFunction struct{}
+
+func (f *ParamValueFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "param_value"
+}
+
+func (f *ParamValueFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a string the way additional_litellm_params values are sent.",
+		Description: "Converts a string to the native value litellm_model sends for an additional_litellm_params entry: integers and floats become numbers, \"true\"/\"false\" become booleans, JSON arrays and objects are decoded, and anything else stays a string.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "additional_litellm_params value.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *ParamValueFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	converted, err := nativeToAttrValue(convertStringValue(value))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.DynamicValue(converted)))
+}
+
+// nativeToAttrValue converts a value produced by convertStringValue, including
+// decoded JSON, to a Terraform value. JSON arrays become tuples and objects
+// become objects, since their elements need not share a type.
+func nativeToAttrValue(v interface{}) (attr.Value, error) {
+	switch val := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(val), nil
+	case bool:
+		return types.BoolValue(val), nil
+	case int64:
+		return types.NumberValue(new(big.Float).SetInt64(val)), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(val)), nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(val))
+		elems := make([]attr.Value, 0, len(val))
+		for _, item := range val {
+			elem, err := nativeToAttrValue(item)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elem.Type(context.Background()))
+			elems = append(elems, elem)
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert JSON array")
+		}
+		return tuple, nil
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(val))
+		attrs := make(map[string]attr.Value, len(val))
+		for k, item := range val {
+			elem, err := nativeToAttrValue(item)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = elem.Type(context.Background())
+			attrs[k] = elem
+		}
+		obj, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert JSON object")
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("unsupported value of type %T", v)
+	}
+}
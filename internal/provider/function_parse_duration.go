@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ParseDurationFunction{}
+
+func NewParseDurationFunction() function.Function {
+	return &ParseDurationFunction{}
+}
+
+// ParseDurationFunction validates a LiteLLM duration string and returns its
+// length in seconds.
+type ParseDurationFunction struct{}
+
+func (f *ParseDurationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_duration"
+}
+
+func (f *ParseDurationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a LiteLLM duration string into seconds.",
+		Description: "Parses a LiteLLM duration such as \"30s\", \"15m\", \"1h\", \"30d\", \"2w\" or \"1mo\" and returns its length in seconds. Fails for strings LiteLLM would reject. Months count as 30 days; LiteLLM itself resets monthly budgets on calendar boundaries.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "duration",
+				Description: "LiteLLM duration string.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *ParseDurationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var duration string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &duration))
+	if resp.Error != nil {
+		return
+	}
+
+	d, err := parseLiteLLMDuration(duration)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, int64(d.Seconds())))
+}
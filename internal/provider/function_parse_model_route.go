@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseModelRouteFunction{}
+
+var parseModelRouteAttrTypes = map[string]attr.Type{
+	"custom_llm_provider": types.StringType,
+	"base_model":          types.StringType,
+}
+
+func NewParseModelRouteFunction() function.Function {
+	return &ParseModelRouteFunction{}
+}
+
+// ParseModelRouteFunction splits a "provider/model" route into the
+// custom_llm_provider and base_model litellm_model is configured with.
+type ParseModelRouteFunction struct{}
+
+func (f *ParseModelRouteFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_model_route"
+}
+
+func (f *ParseModelRouteFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a LiteLLM model route into provider and base model.",
+		Description: "Splits a \"provider/model\" route at the first \"/\" and returns an object with custom_llm_provider and base_model. It is the inverse of model_route, so base models that contain slashes are preserved.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "route",
+				Description: "Model route, e.g. \"openai/gpt-4o\".",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseModelRouteAttrTypes,
+		},
+	}
+}
+
+func (f *ParseModelRouteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var route string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &route))
+	if resp.Error != nil {
+		return
+	}
+
+	customLLMProvider, baseModel, ok := splitModelRoute(route)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid model route %q: expected \"provider/model\"", route))
+		return
+	}
+
+	result, diags := types.ObjectValue(parseModelRouteAttrTypes, map[string]attr.Value{
+		"custom_llm_provider": types.StringValue(customLLMProvider),
+		"base_model":          types.StringValue(baseModel),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls f with args and returns its result and error.
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestCostPerTokenToPerMillionFunction(t *testing.T) {
+	t.Parallel()
+
+	cost, _ := new(big.Float).SetPrec(512).SetString("0.0000025")
+	got, err := runFunction(t, NewCostPerTokenToPerMillionFunction(), types.NumberUnknown(), types.NumberValue(cost))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	perMillion, _ := got.(types.Number).ValueBigFloat().Float64()
+	if perMillion != 2.5 {
+		t.Errorf("cost_per_token_to_per_million(0.0000025) = %v, want 2.5", perMillion)
+	}
+	// The provider divides by one million again; the round trip must agree.
+	if perMillion/1000000.0 != 0.0000025 {
+		t.Errorf("round trip = %v, want 0.0000025", perMillion/1000000.0)
+	}
+}
+
+func TestParseDurationFunction(t *testing.T) {
+	t.Parallel()
+
+	got, err := runFunction(t, NewParseDurationFunction(), types.Int64Unknown(), types.StringValue("30d"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.(types.Int64).ValueInt64() != 2592000 {
+		t.Errorf("parse_duration(\"30d\") = %v, want 2592000", got)
+	}
+
+	if _, err := runFunction(t, NewParseDurationFunction(), types.Int64Unknown(), types.StringValue("30 days")); err == nil {
+		t.Error("parse_duration should reject an invalid duration")
+	}
+}
+
+func TestModelRouteFunctions(t *testing.T) {
+	t.Parallel()
+
+	got, err := runFunction(t, NewModelRouteFunction(), types.StringUnknown(), types.StringValue("openrouter"), types.StringValue("anthropic/claude-3"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	route := got.(types.String).ValueString()
+	if route != "openrouter/anthropic/claude-3" {
+		t.Fatalf("model_route = %q, want openrouter/anthropic/claude-3", route)
+	}
+
+	got, err = runFunction(t, NewParseModelRouteFunction(), types.ObjectUnknown(parseModelRouteAttrTypes), types.StringValue(route))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	attrs := got.(types.Object).Attributes()
+	if attrs["custom_llm_provider"].(types.String).ValueString() != "openrouter" || attrs["base_model"].(types.String).ValueString() != "anthropic/claude-3" {
+		t.Errorf("parse_model_route(%q) = %v", route, attrs)
+	}
+
+	if _, err := runFunction(t, NewParseModelRouteFunction(), types.ObjectUnknown(parseModelRouteAttrTypes), types.StringValue("gpt-4o")); err == nil {
+		t.Error("parse_model_route should reject a route without a provider")
+	}
+}
+
+func TestNormalizeNumericFunction(t *testing.T) {
+	t.Parallel()
+
+	for input, want := range map[string]string{"2.5e-06": "0.0000025", "500": "500", "auto": "auto"} {
+		got, err := runFunction(t, NewNormalizeNumericFunction(), types.StringUnknown(), types.StringValue(input))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got.(types.String).ValueString() != want {
+			t.Errorf("normalize_numeric(%q) = %v, want %q", input, got, want)
+		}
+	}
+}
+
+func TestParamValueFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]attr.Value{
+		"300":   types.NumberValue(new(big.Float).SetInt64(300)),
+		"true":  types.BoolValue(true),
+		"azure": types.StringValue("azure"),
+	}
+	for input, want := range tests {
+		got, err := runFunction(t, NewParamValueFunction(), types.DynamicUnknown(), types.StringValue(input))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !got.(types.Dynamic).UnderlyingValue().Equal(want) {
+			t.Errorf("param_value(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	got, err := runFunction(t, NewParamValueFunction(), types.DynamicUnknown(), types.StringValue(`{"region": "eu", "regions": ["eu", "us"]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	obj, ok := got.(types.Dynamic).UnderlyingValue().(types.Object)
+	if !ok {
+		t.Fatalf("param_value of a JSON object should return an object, got %T", got.(types.Dynamic).UnderlyingValue())
+	}
+	if _, ok := obj.Attributes()["regions"].(types.Tuple); !ok {
+		t.Errorf("JSON arrays should become tuples, got %v", obj.Attributes()["regions"])
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Resources that can be discovered with `terraform query` expose a resource
+// identity: the API identifier Terraform records alongside the state and
+// that an import block's identity argument accepts.
+
+// setResourceIdentity stores v as the resource identity. identity is nil when
+// Terraform does not support resource identity.
+func setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics, v interface{}) {
+	if identity == nil {
+		return
+	}
+	diags.Append(identity.Set(ctx, v)...)
+}
+
+// importID returns the import ID, or the identity attribute attrName when
+// the resource is imported through an import block's identity argument.
+func importID(ctx context.Context, req resource.ImportStateRequest, attrName string, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+	var v types.String
+	diags.Append(req.Identity.GetAttribute(ctx, path.Root(attrName), &v)...)
+	return v.ValueString()
+}
+
+// keyToken returns the hashed token LiteLLM stores for a key. Raw keys start
+// with "sk-" and are hashed with SHA256; any other value is already a hashed
+// token, which LiteLLM accepts wherever it accepts a key.
+func keyToken(key string) string {
+	if !strings.HasPrefix(key, "sk-") {
+		return key
+	}
+	h := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("%x", h)
+}
+
+// hashedTokenPattern matches the SHA256 hex digest LiteLLM stores as a key's
+// token.
+var hashedTokenPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// uniqueImportMatch resolves a natural identifier used as an import ID. ids
+// holds the IDs of every object the name matched; exactly one is required.
+func uniqueImportMatch(kind, field, name string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s with %s %q was found", kind, field, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%s %q is ambiguous: it matches %d %ss (%s). Import by ID instead",
+			field, name, len(ids), kind, strings.Join(ids, ", "))
+	}
+}
+
+// importIDAfterListError is used by the import resolvers when the list
+// endpoint that resolves natural identifiers fails, for example because the
+// API key lacks permission to list or the proxy is older. The import ID is
+// then used as the object's ID, as it was before natural identifiers were
+// supported, and Read reports an ID that does not exist.
+func importIDAfterListError(ctx context.Context, kind, id string, err error) (string, error) {
+	tflog.Warn(ctx, fmt.Sprintf("Unable to list %ss to resolve the import ID; importing it as an ID", kind), map[string]interface{}{
+		"id":    id,
+		"error": err.Error(),
+	})
+	return id, nil
+}
+
+// addImportError reports a failure to resolve import ID id. API failures are
+// reported like any other client error.
+func addImportError(ctx context.Context, diags *diag.Diagnostics, id string, err error) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		addClientError(ctx, diags, nil, fmt.Sprintf("Unable to resolve import ID %q", id), err)
+		return
+	}
+	diags.AddError("Invalid Import ID", err.Error())
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newImportTestClient returns a client for a server that answers every
+// request with body.
+func newImportTestClient(t *testing.T, wantPath string, body interface{}) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != wantPath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+}
+
+func TestUniqueImportMatch(t *testing.T) {
+	t.Parallel()
+
+	if _, err := uniqueImportMatch("team", "team_alias", "platform", nil); err == nil || !strings.Contains(err.Error(), "no team") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if id, err := uniqueImportMatch("team", "team_alias", "platform", []string{"team-1"}); err != nil || id != "team-1" {
+		t.Fatalf("expected team-1, got %q (%v)", id, err)
+	}
+	_, err := uniqueImportMatch("team", "team_alias", "platform", []string{"team-1", "team-2"})
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") || !strings.Contains(err.Error(), "team-1, team-2") {
+		t.Fatalf("expected ambiguity error naming both teams, got %v", err)
+	}
+}
+
+func TestResolveImportKey(t *testing.T) {
+	t.Parallel()
+
+	client := newImportTestClient(t, "/key/list", map[string]interface{}{
+		"keys": []interface{}{
+			map[string]interface{}{"token": "token-1", "key_alias": "ci"},
+			map[string]interface{}{"token": "token-2", "key_alias": "other"},
+			map[string]interface{}{"token": "token-3", "key_alias": "dup"},
+			map[string]interface{}{"token": "token-4", "key_alias": "dup"},
+		},
+		"total_pages": 1,
+	})
+	r := &KeyResource{client: client}
+	ctx := context.Background()
+
+	token := keyToken("sk-1234")
+	for _, id := range []string{"sk-1234", token} {
+		got, err := r.resolveImportKey(ctx, id)
+		if err != nil || got != id {
+			t.Fatalf("expected %s to be imported as is, got %q (%v)", id, got, err)
+		}
+	}
+
+	if got, err := r.resolveImportKey(ctx, "ci"); err != nil || got != "token-1" {
+		t.Fatalf("expected token-1, got %q (%v)", got, err)
+	}
+	if _, err := r.resolveImportKey(ctx, "dup"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Fatalf("expected ambiguity error, got %v", err)
+	}
+	if _, err := r.resolveImportKey(ctx, "missing"); err == nil || !strings.Contains(err.Error(), "no key") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestResolveImportTeamID(t *testing.T) {
+	t.Parallel()
+
+	client := newImportTestClient(t, "/team/list", []interface{}{
+		map[string]interface{}{"team_id": "team-1", "team_alias": "platform"},
+		map[string]interface{}{"team_id": "team-2", "team_alias": "shared"},
+		map[string]interface{}{"team_id": "team-3", "team_alias": "shared"},
+	})
+	r := &TeamResource{client: client}
+	ctx := context.Background()
+
+	tests := map[string]string{
+		"team-2":   "team-2",
+		"platform": "team-1",
+		"team-9":   "team-9",
+	}
+	for id, want := range tests {
+		if got, err := r.resolveImportTeamID(ctx, id); err != nil || got != want {
+			t.Fatalf("%s: expected %s, got %q (%v)", id, want, got, err)
+		}
+	}
+	if _, err := r.resolveImportTeamID(ctx, "shared"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Fatalf("expected ambiguity error, got %v", err)
+	}
+}
+
+func TestResolveImportModelID(t *testing.T) {
+	t.Parallel()
+
+	client := newImportTestClient(t, "/model/info", map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{
+				"model_name": "gpt-4o",
+				"model_info": map[string]interface{}{"id": "model-1"},
+			},
+			map[string]interface{}{
+				"model_name": "model_name_team-1_abc",
+				"model_info": map[string]interface{}{"id": "model-2", "team_id": "team-1", "team_public_model_name": "gpt-4o"},
+			},
+			map[string]interface{}{
+				"model_name": "claude",
+				"model_info": map[string]interface{}{"id": "model-3"},
+			},
+		},
+	})
+	r := &ModelResource{client: client}
+	ctx := context.Background()
+
+	tests := map[string]string{
+		"model-1":       "model-1",
+		"claude":        "model-3",
+		"team-1:gpt-4o": "model-2",
+		"model-9":       "model-9",
+	}
+	for id, want := range tests {
+		if got, err := r.resolveImportModelID(ctx, id); err != nil || got != want {
+			t.Fatalf("%s: expected %s, got %q (%v)", id, want, got, err)
+		}
+	}
+	_, err := r.resolveImportModelID(ctx, "gpt-4o")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") || !strings.Contains(err.Error(), "<team_id>:<model_name>") {
+		t.Fatalf("expected ambiguity error suggesting the team form, got %v", err)
+	}
+}
+
+func TestResolveImportServerID(t *testing.T) {
+	t.Parallel()
+
+	client := newImportTestClient(t, "/v1/mcp/server", []interface{}{
+		map[string]interface{}{"server_id": "server-1", "server_name": "github"},
+		map[string]interface{}{"server_id": "server-2", "server_name": "jira"},
+		map[string]interface{}{"server_id": "server-3", "server_name": "jira"},
+	})
+	r := &MCPServerResource{client: client}
+	ctx := context.Background()
+
+	if got, err := r.resolveImportServerID(ctx, "github"); err != nil || got != "server-1" {
+		t.Fatalf("expected server-1, got %q (%v)", got, err)
+	}
+	if got, err := r.resolveImportServerID(ctx, "server-2"); err != nil || got != "server-2" {
+		t.Fatalf("expected server-2, got %q (%v)", got, err)
+	}
+	if _, err := r.resolveImportServerID(ctx, "jira"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Fatalf("expected ambiguity error, got %v", err)
+	}
+}
+
+func TestResolveImportIDWhenListingFails(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"message":"not allowed to list"}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+	ctx := context.Background()
+
+	if got, err := (&TeamResource{client: client}).resolveImportTeamID(ctx, "team-1"); err != nil || got != "team-1" {
+		t.Errorf("team: expected team-1, got %q (%v)", got, err)
+	}
+	if got, err := (&ModelResource{client: client}).resolveImportModelID(ctx, "model-1"); err != nil || got != "model-1" {
+		t.Errorf("model: expected model-1, got %q (%v)", got, err)
+	}
+	if got, err := (&MCPServerResource{client: client}).resolveImportServerID(ctx, "server-1"); err != nil || got != "server-1" {
+		t.Errorf("MCP server: expected server-1, got %q (%v)", got, err)
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// listPageSize is the page size requested from paginated list endpoints.
+const listPageSize = 100
+
+// newListResult returns a list result for one discovered object. When the
+// request includes resources, readResource populates the resource, which
+// starts out with every attribute null.
+func newListResult(ctx context.Context, req list.ListRequest, displayName string, identity interface{}, readResource func(context.Context, *tfsdk.Resource) diag.Diagnostics) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+	result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+	if req.IncludeResource && !result.Diagnostics.HasError() {
+		result.Resource.Raw = nullObjectValue(ctx, req.ResourceSchema.Type())
+		result.Diagnostics.Append(readResource(ctx, result.Resource)...)
+	}
+	return result
+}
+
+// listLimitReached reports whether count results satisfy the request's limit.
+func listLimitReached(req list.ListRequest, count int64) bool {
+	return req.Limit > 0 && count >= req.Limit
+}
+
+// nullObjectValue returns an object of type t whose attributes are all null,
+// so that it can be read into a resource model with typed null values.
+func nullObjectValue(ctx context.Context, t attr.Type) tftypes.Value {
+	objType, ok := t.TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		return tftypes.NewValue(t.TerraformType(ctx), nil)
+	}
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, attrType := range objType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	return tftypes.NewValue(objType, attrs)
+}
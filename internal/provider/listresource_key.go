@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &KeyListResource{}
+var _ list.ListResourceWithConfigure = &KeyListResource{}
+
+func NewKeyListResource() list.ListResource {
+	return &KeyListResource{}
+}
+
+type KeyListResource struct {
+	client *Client
+}
+
+type KeyListResourceModel struct {
+	TeamID         types.String `tfsdk:"team_id"`
+	UserID         types.String `tfsdk:"user_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	KeyAlias       types.String `tfsdk:"key_alias"`
+}
+
+func (l *KeyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+func (l *KeyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LiteLLM API keys from /key/list.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description: "Only list keys of this team.",
+				Optional:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Only list keys of this user.",
+				Optional:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "Only list keys of this organization.",
+				Optional:    true,
+			},
+			"key_alias": schema.StringAttribute{
+				Description: "Only list keys with this alias.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *KeyListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client
+}
+
+func (l *KeyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config KeyListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for page := 1; ; page++ {
+			keys, totalPages, err := l.listKeys(ctx, config, page)
+			if err != nil {
+				var diags diag.Diagnostics
+				addClientError(ctx, &diags, nil, "Unable to list keys", err)
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, key := range keys {
+				token, _ := key["token"].(string)
+				if token == "" {
+					continue
+				}
+				displayName := token
+				if alias, ok := key["key_alias"].(string); ok && alias != "" {
+					displayName = alias
+				}
+
+				result := newListResult(ctx, req, displayName, KeyIdentityModel{Token: types.StringValue(token)},
+					func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+						return l.readResource(ctx, res, token)
+					})
+				if !push(result) {
+					return
+				}
+				count++
+				if listLimitReached(req, count) {
+					return
+				}
+			}
+
+			if page >= totalPages {
+				return
+			}
+		}
+	}
+}
+
+// listKeys fetches one page of keys. Keys are returned as objects carrying
+// at least their hashed token.
+func (l *KeyListResource) listKeys(ctx context.Context, config KeyListResourceModel, page int) ([]map[string]interface{}, int, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("size", strconv.Itoa(listPageSize))
+	params.Set("return_full_object", "true")
+	if !config.TeamID.IsNull() && config.TeamID.ValueString() != "" {
+		params.Set("team_id", config.TeamID.ValueString())
+	}
+	if !config.UserID.IsNull() && config.UserID.ValueString() != "" {
+		params.Set("user_id", config.UserID.ValueString())
+	}
+	if !config.OrganizationID.IsNull() && config.OrganizationID.ValueString() != "" {
+		params.Set("organization_id", config.OrganizationID.ValueString())
+	}
+	if !config.KeyAlias.IsNull() && config.KeyAlias.ValueString() != "" {
+		params.Set("key_alias", config.KeyAlias.ValueString())
+	}
+
+	var result map[string]interface{}
+	if err := l.client.DoRequestWithResponse(ctx, "GET", "/key/list?"+params.Encode(), nil, &result); err != nil {
+		return nil, 0, err
+	}
+
+	var keysData []interface{}
+	if keys, ok := result["keys"].([]interface{}); ok {
+		keysData = keys
+	} else if dataArr, ok := result["data"].([]interface{}); ok {
+		keysData = dataArr
+	}
+
+	keys := make([]map[string]interface{}, 0, len(keysData))
+	for _, k := range keysData {
+		switch v := k.(type) {
+		case string:
+			// Older servers ignore return_full_object and list tokens only.
+			keys = append(keys, map[string]interface{}{"token": v})
+		case map[string]interface{}:
+			// key_name is an abbreviated display value (sk-...abcd), not the
+			// token, so rows without a token cannot be imported.
+			if token, _ := v["token"].(string); token == "" {
+				continue
+			}
+			keys = append(keys, v)
+		}
+	}
+
+	totalPages := 1
+	if v, ok := result["total_pages"].(float64); ok {
+		totalPages = int(v)
+	}
+	return keys, totalPages, nil
+}
+
+func (l *KeyListResource) readResource(ctx context.Context, res *tfsdk.Resource, token string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var data KeyResourceModel
+
+	diags.Append(res.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Like an import, the key attribute holds the hashed token since the
+	// raw key cannot be recovered.
+	data.Key = types.StringValue(token)
+	data.ID = types.StringValue(hashKeyForID(token))
+	if err := (&KeyResource{client: l.client}).readKey(ctx, &data); err != nil {
+		addClientError(ctx, &diags, nil, "Unable to read key", err)
+		return diags
+	}
+
+	diags.Append(res.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &MCPServerListResource{}
+var _ list.ListResourceWithConfigure = &MCPServerListResource{}
+
+func NewMCPServerListResource() list.ListResource {
+	return &MCPServerListResource{}
+}
+
+type MCPServerListResource struct {
+	client *Client
+}
+
+type MCPServerListResourceModel struct {
+	Transport types.String `tfsdk:"transport"`
+}
+
+func (l *MCPServerListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mcp_server"
+}
+
+func (l *MCPServerListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LiteLLM MCP servers from /v1/mcp/server.",
+		Attributes: map[string]schema.Attribute{
+			"transport": schema.StringAttribute{
+				Description: "Only list servers using this transport (e.g. 'http', 'sse').",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *MCPServerListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client
+}
+
+func (l *MCPServerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config MCPServerListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		servers, err := l.listMCPServers(ctx)
+		if err != nil {
+			var diags diag.Diagnostics
+			addClientError(ctx, &diags, nil, "Unable to list MCP servers", err)
+			push(list.ListResult{Diagnostics: diags})
+			return
+		}
+
+		var count int64
+		for _, server := range servers {
+			serverID, _ := server["server_id"].(string)
+			if serverID == "" {
+				continue
+			}
+			if !config.Transport.IsNull() && config.Transport.ValueString() != "" {
+				if transport, _ := server["transport"].(string); transport != config.Transport.ValueString() {
+					continue
+				}
+			}
+			displayName := serverID
+			if name, ok := server["server_name"].(string); ok && name != "" {
+				displayName = name
+			}
+
+			result := newListResult(ctx, req, displayName, MCPServerIdentityModel{ServerID: types.StringValue(serverID)},
+				func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+					return l.readResource(ctx, res, serverID)
+				})
+			if !push(result) {
+				return
+			}
+			count++
+			if listLimitReached(req, count) {
+				return
+			}
+		}
+	}
+}
+
+func (l *MCPServerListResource) listMCPServers(ctx context.Context) ([]map[string]interface{}, error) {
+	var rawResult interface{}
+	if err := l.client.DoRequestWithResponse(ctx, "GET", "/v1/mcp/server", nil, &rawResult); err != nil {
+		return nil, err
+	}
+
+	var serversData []interface{}
+	switch result := rawResult.(type) {
+	case []interface{}:
+		serversData = result
+	case map[string]interface{}:
+		if dataArr, ok := result["data"].([]interface{}); ok {
+			serversData = dataArr
+		} else if serversArr, ok := result["servers"].([]interface{}); ok {
+			serversData = serversArr
+		}
+	}
+
+	servers := make([]map[string]interface{}, 0, len(serversData))
+	for _, s := range serversData {
+		if serverMap, ok := s.(map[string]interface{}); ok {
+			servers = append(servers, serverMap)
+		}
+	}
+	return servers, nil
+}
+
+func (l *MCPServerListResource) readResource(ctx context.Context, res *tfsdk.Resource, serverID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var data MCPServerResourceModel
+
+	diags.Append(res.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ID = types.StringValue(serverID)
+	data.ServerID = types.StringValue(serverID)
+	if err := (&MCPServerResource{client: l.client}).readMCPServer(ctx, &data); err != nil {
+		addClientError(ctx, &diags, nil, "Unable to read MCP server", err)
+		return diags
+	}
+
+	diags.Append(res.Set(ctx, &data)...)
+	return diags
+}
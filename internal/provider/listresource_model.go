@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &ModelListResource{}
+var _ list.ListResourceWithConfigure = &ModelListResource{}
+
+func NewModelListResource() list.ListResource {
+	return &ModelListResource{}
+}
+
+type ModelListResource struct {
+	client *Client
+}
+
+type ModelListResourceModel struct {
+	TeamID            types.String `tfsdk:"team_id"`
+	CustomLLMProvider types.String `tfsdk:"custom_llm_provider"`
+}
+
+func (l *ModelListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model"
+}
+
+func (l *ModelListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LiteLLM models from /model/info.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Description: "Only list models of this team.",
+				Optional:    true,
+			},
+			"custom_llm_provider": schema.StringAttribute{
+				Description: "Only list models of this provider (e.g. 'openai').",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *ModelListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client
+}
+
+func (l *ModelListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config ModelListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		models, err := l.listModels(ctx, config)
+		if err != nil {
+			var diags diag.Diagnostics
+			addClientError(ctx, &diags, nil, "Unable to list models", err)
+			push(list.ListResult{Diagnostics: diags})
+			return
+		}
+
+		var count int64
+		for _, model := range models {
+			modelInfo, _ := model["model_info"].(map[string]interface{})
+			modelID, _ := modelInfo["id"].(string)
+			if modelID == "" {
+				continue
+			}
+			if !config.CustomLLMProvider.IsNull() && config.CustomLLMProvider.ValueString() != "" {
+				litellmParams, _ := model["litellm_params"].(map[string]interface{})
+				if provider, _ := litellmParams["custom_llm_provider"].(string); provider != config.CustomLLMProvider.ValueString() {
+					continue
+				}
+			}
+
+			result := newListResult(ctx, req, listedModelName(model, modelID), ModelIdentityModel{ModelID: types.StringValue(modelID)},
+				func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+					return l.readResource(ctx, res, modelID)
+				})
+			if !push(result) {
+				return
+			}
+			count++
+			if listLimitReached(req, count) {
+				return
+			}
+		}
+	}
+}
+
+func (l *ModelListResource) listModels(ctx context.Context, config ModelListResourceModel) ([]map[string]interface{}, error) {
+	endpoint := "/model/info"
+	if !config.TeamID.IsNull() && config.TeamID.ValueString() != "" {
+		endpoint += "?" + url.Values{"team_id": {config.TeamID.ValueString()}}.Encode()
+	}
+
+	var result map[string]interface{}
+	if err := l.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+
+	var modelsData []interface{}
+	if dataArr, ok := result["data"].([]interface{}); ok {
+		modelsData = dataArr
+	} else if models, ok := result["models"].([]interface{}); ok {
+		modelsData = models
+	}
+
+	models := make([]map[string]interface{}, 0, len(modelsData))
+	for _, m := range modelsData {
+		if modelMap, ok := m.(map[string]interface{}); ok {
+			models = append(models, modelMap)
+		}
+	}
+	return models, nil
+}
+
+// listedModelName returns the user-facing name of a model from /model/info,
+// preferring team_public_model_name for team-scoped models.
+func listedModelName(model map[string]interface{}, fallback string) string {
+	if modelInfo, ok := model["model_info"].(map[string]interface{}); ok {
+		if teamID, _ := modelInfo["team_id"].(string); teamID != "" {
+			if publicName, ok := modelInfo["team_public_model_name"].(string); ok && publicName != "" {
+				return publicName
+			}
+		}
+	}
+	if modelName, ok := model["model_name"].(string); ok && modelName != "" {
+		return modelName
+	}
+	return fallback
+}
+
+func (l *ModelListResource) readResource(ctx context.Context, res *tfsdk.Resource, modelID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var data ModelResourceModel
+
+	diags.Append(res.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ID = types.StringValue(modelID)
+	if err := (&ModelResource{client: l.client}).readModel(ctx, &data); err != nil {
+		addClientError(ctx, &diags, nil, "Unable to read model", err)
+		return diags
+	}
+
+	diags.Append(res.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &TeamListResource{}
+var _ list.ListResourceWithConfigure = &TeamListResource{}
+
+func NewTeamListResource() list.ListResource {
+	return &TeamListResource{}
+}
+
+type TeamListResource struct {
+	client *Client
+}
+
+type TeamListResourceModel struct {
+	OrganizationID types.String `tfsdk:"organization_id"`
+	UserID         types.String `tfsdk:"user_id"`
+}
+
+func (l *TeamListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (l *TeamListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LiteLLM teams from /team/list.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Description: "Only list teams of this organization.",
+				Optional:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Only list teams this user is a member of.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *TeamListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client
+}
+
+func (l *TeamListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config TeamListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		teams, err := l.listTeams(ctx, config)
+		if err != nil {
+			var diags diag.Diagnostics
+			addClientError(ctx, &diags, nil, "Unable to list teams", err)
+			push(list.ListResult{Diagnostics: diags})
+			return
+		}
+
+		var count int64
+		for _, team := range teams {
+			teamID, _ := team["team_id"].(string)
+			if teamID == "" {
+				continue
+			}
+			displayName := teamID
+			if alias, ok := team["team_alias"].(string); ok && alias != "" {
+				displayName = alias
+			}
+
+			result := newListResult(ctx, req, displayName, TeamIdentityModel{TeamID: types.StringValue(teamID)},
+				func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+					return l.readResource(ctx, res, teamID)
+				})
+			if !push(result) {
+				return
+			}
+			count++
+			if listLimitReached(req, count) {
+				return
+			}
+		}
+	}
+}
+
+func (l *TeamListResource) listTeams(ctx context.Context, config TeamListResourceModel) ([]map[string]interface{}, error) {
+	params := url.Values{}
+	if !config.OrganizationID.IsNull() && config.OrganizationID.ValueString() != "" {
+		params.Set("organization_id", config.OrganizationID.ValueString())
+	}
+	if !config.UserID.IsNull() && config.UserID.ValueString() != "" {
+		params.Set("user_id", config.UserID.ValueString())
+	}
+	endpoint := "/team/list"
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var rawResult interface{}
+	if err := l.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &rawResult); err != nil {
+		return nil, err
+	}
+
+	var teamsData []interface{}
+	switch result := rawResult.(type) {
+	case []interface{}:
+		teamsData = result
+	case map[string]interface{}:
+		if teams, ok := result["teams"].([]interface{}); ok {
+			teamsData = teams
+		} else if dataArr, ok := result["data"].([]interface{}); ok {
+			teamsData = dataArr
+		}
+	}
+
+	teams := make([]map[string]interface{}, 0, len(teamsData))
+	for _, t := range teamsData {
+		if teamMap, ok := t.(map[string]interface{}); ok {
+			teams = append(teams, teamMap)
+		}
+	}
+	return teams, nil
+}
+
+func (l *TeamListResource) readResource(ctx context.Context, res *tfsdk.Resource, teamID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var data TeamResourceModel
+
+	diags.Append(res.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ID = types.StringValue(teamID)
+	if err := (&TeamResource{client: l.client}).readTeam(ctx, &data); err != nil {
+		addClientError(ctx, &diags, nil, "Unable to read team", err)
+		return diags
+	}
+
+	diags.Append(res.Set(ctx, &data)...)
+	return diags
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestKeyToken(t *testing.T) {
+	t.Parallel()
+
+	// sha256("sk-1234") as stored by LiteLLM.
+	want := "88dc28d0f030c55ed4ab77ed8faf098196cb1c05df778539800c9f1243fe6b4b"
+	if got := keyToken("sk-1234"); got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+	if got := keyToken(want); got != want {
+		t.Fatalf("expected hashed token to be returned unchanged, got %s", got)
+	}
+}
+
+func TestListKeysPaginatesAndParsesTokens(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/key/list" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("team_id"); got != "team-1" {
+			t.Errorf("expected team_id filter, got %q", got)
+		}
+		if got := r.URL.Query().Get("return_full_object"); got != "true" {
+			t.Errorf("expected return_full_object=true, got %q", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []interface{}{
+					map[string]interface{}{"token": "token-1", "key_alias": "first"},
+					map[string]interface{}{"key_name": "sk-...abcd"},
+					map[string]interface{}{"token": "token-2"},
+				},
+				"total_pages": 2,
+			})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys":        []interface{}{"token-3"},
+				"total_pages": 2,
+			})
+		}
+	}))
+	defer server.Close()
+
+	l := &KeyListResource{client: &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}}
+	config := KeyListResourceModel{
+		TeamID:         types.StringValue("team-1"),
+		UserID:         types.StringNull(),
+		OrganizationID: types.StringNull(),
+		KeyAlias:       types.StringNull(),
+	}
+
+	keys, totalPages, err := l.listKeys(context.Background(), config, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totalPages != 2 {
+		t.Fatalf("expected 2 pages, got %d", totalPages)
+	}
+	if len(keys) != 2 || keys[0]["token"] != "token-1" || keys[1]["token"] != "token-2" {
+		t.Fatalf("expected the key without a token to be skipped, got %v", keys)
+	}
+
+	keys, _, err = l.listKeys(context.Background(), config, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0]["token"] != "token-3" {
+		t.Fatalf("unexpected keys on page 2: %v", keys)
+	}
+}
+
+func TestListUsersSendsFilters(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("role") != "internal_user" || q.Get("team") != "team-1" || q.Get("page_size") != "100" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"users":       []interface{}{map[string]interface{}{"user_id": "user-1"}},
+			"total_pages": 3,
+		})
+	}))
+	defer server.Close()
+
+	l := &UserListResource{client: &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}}
+	users, totalPages, err := l.listUsers(context.Background(), UserListResourceModel{
+		Role:      types.StringValue("internal_user"),
+		UserEmail: types.StringNull(),
+		TeamID:    types.StringValue("team-1"),
+	}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if totalPages != 3 || len(users) != 1 || users[0]["user_id"] != "user-1" {
+		t.Fatalf("unexpected result: %v (%d pages)", users, totalPages)
+	}
+}
+
+func TestListedModelName(t *testing.T) {
+	t.Parallel()
+
+	teamModel := map[string]interface{}{
+		"model_name": "model_name_team-1_abc",
+		"model_info": map[string]interface{}{
+			"id":                     "model-1",
+			"team_id":                "team-1",
+			"team_public_model_name": "gpt-4o",
+		},
+	}
+	if got := listedModelName(teamModel, "model-1"); got != "gpt-4o" {
+		t.Fatalf("expected team public model name, got %s", got)
+	}
+
+	model := map[string]interface{}{
+		"model_name": "claude",
+		"model_info": map[string]interface{}{"id": "model-2"},
+	}
+	if got := listedModelName(model, "model-2"); got != "claude" {
+		t.Fatalf("expected model name, got %s", got)
+	}
+
+	if got := listedModelName(map[string]interface{}{}, "model-3"); got != "model-3" {
+		t.Fatalf("expected fallback, got %s", got)
+	}
+}
+
+func TestTeamListResourceIncludesResource(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/team/list":
+			_ = json.NewEncoder(w).Encode([]interface{}{
+				map[string]interface{}{"team_id": "team-1", "team_alias": "platform"},
+				map[string]interface{}{"team_id": "team-2"},
+			})
+		case "/team/info":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"team_id": r.URL.Query().Get("team_id"),
+				"team_info": map[string]interface{}{
+					"team_id":    r.URL.Query().Get("team_id"),
+					"team_alias": "platform",
+				},
+			})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{})
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &TeamResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	l := &TeamListResource{client: &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}}
+	var configSchemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchemaResp)
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Raw:    nullObjectValue(ctx, configSchemaResp.Schema.Type()),
+			Schema: configSchemaResp.Schema,
+		},
+		IncludeResource:        true,
+		Limit:                  1,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+	var stream list.ListResultsStream
+	l.List(ctx, req, &stream)
+
+	var results []list.ListResult
+	stream.Results(func(result list.ListResult) bool {
+		results = append(results, result)
+		return true
+	})
+
+	if len(results) != 1 {
+		t.Fatalf("expected the limit to stop after 1 result, got %d", len(results))
+	}
+	result := results[0]
+	if result.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+	}
+	if result.DisplayName != "platform" {
+		t.Fatalf("expected display name 'platform', got %s", result.DisplayName)
+	}
+
+	var identity TeamIdentityModel
+	result.Identity.Get(ctx, &identity)
+	if identity.TeamID.ValueString() != "team-1" {
+		t.Fatalf("expected identity team-1, got %s", identity.TeamID)
+	}
+
+	var data TeamResourceModel
+	result.Resource.Get(ctx, &data)
+	if data.ID.ValueString() != "team-1" || data.TeamAlias.ValueString() != "platform" {
+		t.Fatalf("unexpected resource: id=%s alias=%s", data.ID, data.TeamAlias)
+	}
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &UserListResource{}
+var _ list.ListResourceWithConfigure = &UserListResource{}
+
+func NewUserListResource() list.ListResource {
+	return &UserListResource{}
+}
+
+type UserListResource struct {
+	client *Client
+}
+
+type UserListResourceModel struct {
+	Role      types.String `tfsdk:"role"`
+	UserEmail types.String `tfsdk:"user_email"`
+	TeamID    types.String `tfsdk:"team_id"`
+}
+
+func (l *UserListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (l *UserListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists LiteLLM users from /user/list.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Description: "Only list users with this role (e.g. 'internal_user').",
+				Optional:    true,
+			},
+			"user_email": schema.StringAttribute{
+				Description: "Only list users whose email matches this value.",
+				Optional:    true,
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Only list members of this team.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (l *UserListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	l.client = client
+}
+
+func (l *UserListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config UserListResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for page := 1; ; page++ {
+			users, totalPages, err := l.listUsers(ctx, config, page)
+			if err != nil {
+				var diags diag.Diagnostics
+				addClientError(ctx, &diags, nil, "Unable to list users", err)
+				push(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, user := range users {
+				userID, _ := user["user_id"].(string)
+				if userID == "" {
+					continue
+				}
+				displayName := userID
+				if email, ok := user["user_email"].(string); ok && email != "" {
+					displayName = email
+				}
+
+				result := newListResult(ctx, req, displayName, UserIdentityModel{UserID: types.StringValue(userID)},
+					func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+						return l.readResource(ctx, res, userID)
+					})
+				if !push(result) {
+					return
+				}
+				count++
+				if listLimitReached(req, count) {
+					return
+				}
+			}
+
+			if page >= totalPages {
+				return
+			}
+		}
+	}
+}
+
+func (l *UserListResource) listUsers(ctx context.Context, config UserListResourceModel, page int) ([]map[string]interface{}, int, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("page_size", strconv.Itoa(listPageSize))
+	if !config.Role.IsNull() && config.Role.ValueString() != "" {
+		params.Set("role", config.Role.ValueString())
+	}
+	if !config.UserEmail.IsNull() && config.UserEmail.ValueString() != "" {
+		params.Set("user_email", config.UserEmail.ValueString())
+	}
+	if !config.TeamID.IsNull() && config.TeamID.ValueString() != "" {
+		params.Set("team", config.TeamID.ValueString())
+	}
+
+	var result map[string]interface{}
+	if err := l.client.DoRequestWithResponse(ctx, "GET", "/user/list?"+params.Encode(), nil, &result); err != nil {
+		return nil, 0, err
+	}
+
+	var usersData []interface{}
+	if users, ok := result["users"].([]interface{}); ok {
+		usersData = users
+	} else if dataArr, ok := result["data"].([]interface{}); ok {
+		usersData = dataArr
+	}
+
+	users := make([]map[string]interface{}, 0, len(usersData))
+	for _, u := range usersData {
+		if userMap, ok := u.(map[string]interface{}); ok {
+			users = append(users, userMap)
+		}
+	}
+
+	totalPages := 1
+	if v, ok := result["total_pages"].(float64); ok {
+		totalPages = int(v)
+	}
+	return users, totalPages, nil
+}
+
+func (l *UserListResource) readResource(ctx context.Context, res *tfsdk.Resource, userID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var data UserResourceModel
+
+	diags.Append(res.Get(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ID = types.StringValue(userID)
+	data.UserID = types.StringValue(userID)
+	if err := (&UserResource{client: l.client}).readUser(ctx, &data); err != nil {
+		addClientError(ctx, &diags, nil, "Unable to read user", err)
+		return diags
+	}
+
+	diags.Append(res.Set(ctx, &data)...)
+	return diags
+}
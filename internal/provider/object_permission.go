@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ObjectPermissionModel restricts which MCP servers, tools, vector stores and
+// agents a key or team may use. It follows the agent's object_permission,
+// with tool permissions typed as lists rather than JSON strings.
+type ObjectPermissionModel struct {
+	MCPServers         types.List `tfsdk:"mcp_servers"`
+	MCPAccessGroups    types.List `tfsdk:"mcp_access_groups"`
+	MCPToolPermissions types.Map  `tfsdk:"mcp_tool_permissions"`
+	VectorStores       types.List `tfsdk:"vector_stores"`
+	Agents             types.List `tfsdk:"agents"`
+}
+
+var objectPermissionAttrTypes = map[string]attr.Type{
+	"mcp_servers":          types.ListType{ElemType: types.StringType},
+	"mcp_access_groups":    types.ListType{ElemType: types.StringType},
+	"mcp_tool_permissions": types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+	"vector_stores":        types.ListType{ElemType: types.StringType},
+	"agents":               types.ListType{ElemType: types.StringType},
+}
+
+// objectPermissionSchemaAttribute returns the object_permission attribute
+// shared by keys and teams.
+func objectPermissionSchemaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"mcp_servers": schema.ListAttribute{
+				Description: "MCP server IDs that can be used.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"mcp_access_groups": schema.ListAttribute{
+				Description: "MCP access groups whose servers can be used.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"mcp_tool_permissions": schema.MapAttribute{
+				Description: "Map of MCP server ID to the tools allowed on that server.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			"vector_stores": schema.ListAttribute{
+				Description: "Vector store IDs that can be used.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"agents": schema.ListAttribute{
+				Description: "Agent IDs that can be invoked.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// buildObjectPermissionPayload converts the object_permission object into
+// the API format. Unset fields are omitted; on update,
+// applyObjectPermissionClears empties the ones config no longer sets.
+func buildObjectPermissionPayload(ctx context.Context, obj types.Object) map[string]interface{} {
+	var perm ObjectPermissionModel
+	obj.As(ctx, &perm, basetypes.ObjectAsOptions{})
+
+	payload := map[string]interface{}{}
+	lists := map[string]types.List{
+		"mcp_servers":       perm.MCPServers,
+		"mcp_access_groups": perm.MCPAccessGroups,
+		"vector_stores":     perm.VectorStores,
+		"agents":            perm.Agents,
+	}
+	for field, list := range lists {
+		if !list.IsNull() && !list.IsUnknown() {
+			payload[field] = listToStringSlice(list)
+		}
+	}
+	if !perm.MCPToolPermissions.IsNull() && !perm.MCPToolPermissions.IsUnknown() {
+		var tools map[string][]string
+		perm.MCPToolPermissions.ElementsAs(ctx, &tools, false)
+		payload["mcp_tool_permissions"] = tools
+	}
+
+	return payload
+}
+
+// clearedObjectPermissionPayload empties every field LiteLLM stores for an
+// object permission, for when object_permission is removed from config.
+func clearedObjectPermissionPayload() map[string]interface{} {
+	return map[string]interface{}{
+		"mcp_servers":          []string{},
+		"mcp_access_groups":    []string{},
+		"mcp_tool_permissions": map[string][]string{},
+		"vector_stores":        []string{},
+		"agents":               []string{},
+	}
+}
+
+// applyObjectPermissionClears sets req["object_permission"] so an update
+// removes what config no longer sets. LiteLLM merges object_permission on
+// update, so a removed object is sent with every field empty, and a field
+// removed from a configured object is sent empty rather than omitted.
+func applyObjectPermissionClears(req map[string]interface{}, state, plan types.Object) {
+	if state.IsNull() || state.IsUnknown() {
+		return
+	}
+	if plan.IsNull() {
+		req["object_permission"] = clearedObjectPermissionPayload()
+		return
+	}
+
+	payload, ok := req["object_permission"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for field, empty := range clearedObjectPermissionPayload() {
+		if _, set := payload[field]; set {
+			continue
+		}
+		if prior, ok := state.Attributes()[field]; ok && !prior.IsNull() {
+			payload[field] = empty
+		}
+	}
+}
+
+// parseObjectPermissionFromAPI converts the object_permission returned by
+// LiteLLM into an object. Empty fields stay null when prior did not set
+// them, and an object_permission with nothing set is null unless prior was
+// configured, since LiteLLM creates an empty record for every key and team.
+func parseObjectPermissionFromAPI(ctx context.Context, raw map[string]interface{}, prior types.Object) types.Object {
+	var priorPerm ObjectPermissionModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		prior.As(ctx, &priorPerm, basetypes.ObjectAsOptions{})
+	}
+
+	readList := func(field string, priorList types.List) types.List {
+		if items, ok := raw[field].([]interface{}); ok && len(items) > 0 {
+			return interfaceSliceToStringList(items)
+		}
+		if !priorList.IsNull() && !priorList.IsUnknown() {
+			return types.ListValueMust(types.StringType, []attr.Value{})
+		}
+		return types.ListNull(types.StringType)
+	}
+
+	attrs := map[string]attr.Value{
+		"mcp_servers":       readList("mcp_servers", priorPerm.MCPServers),
+		"mcp_access_groups": readList("mcp_access_groups", priorPerm.MCPAccessGroups),
+		"vector_stores":     readList("vector_stores", priorPerm.VectorStores),
+		"agents":            readList("agents", priorPerm.Agents),
+	}
+
+	toolsType := types.ListType{ElemType: types.StringType}
+	if tools, ok := raw["mcp_tool_permissions"].(map[string]interface{}); ok && len(tools) > 0 {
+		toolMap := make(map[string]attr.Value, len(tools))
+		for server, v := range tools {
+			items, _ := v.([]interface{})
+			toolMap[server] = interfaceSliceToStringList(items)
+		}
+		attrs["mcp_tool_permissions"] = types.MapValueMust(toolsType, toolMap)
+	} else if !priorPerm.MCPToolPermissions.IsNull() && !priorPerm.MCPToolPermissions.IsUnknown() {
+		attrs["mcp_tool_permissions"] = types.MapValueMust(toolsType, map[string]attr.Value{})
+	} else {
+		attrs["mcp_tool_permissions"] = types.MapNull(toolsType)
+	}
+
+	empty := true
+	for _, v := range attrs {
+		if !v.IsNull() {
+			empty = false
+		}
+	}
+	if empty && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ObjectNull(objectPermissionAttrTypes)
+	}
+	return types.ObjectValueMust(objectPermissionAttrTypes, attrs)
+}
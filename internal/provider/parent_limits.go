@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parentLimits holds the limits a team, project or organization places on
+// the keys, projects and teams that belong to it. Nil limits are unlimited,
+// as is an empty model list.
+type parentLimits struct {
+	Kind      string
+	ID        string
+	Models    []string
+	MaxBudget *float64
+	TPMLimit  *int64
+	RPMLimit  *int64
+}
+
+// childLimits are the configured limits of a key, project or team.
+type childLimits struct {
+	Models    types.List
+	MaxBudget types.Float64
+	TPMLimit  types.Int64
+	RPMLimit  types.Int64
+}
+
+// getParentLimits fetches the limits of the team, project or organization
+// with the given ID.
+func (c *Client) getParentLimits(ctx context.Context, kind, id string) (*parentLimits, error) {
+	var endpoint, nested string
+	switch kind {
+	case "team":
+		endpoint, nested = "/team/info?team_id="+url.QueryEscape(id), "team_info"
+	case "project":
+		endpoint = "/project/info?project_id=" + url.QueryEscape(id)
+	case "organization":
+		endpoint, nested = "/organization/info?organization_id="+url.QueryEscape(id), "organization_info"
+	default:
+		return nil, fmt.Errorf("unsupported parent kind %q", kind)
+	}
+
+	var result map[string]interface{}
+	if err := c.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+	info := result
+	if v, ok := result[nested].(map[string]interface{}); ok && nested != "" {
+		info = v
+	}
+
+	return parseParentLimits(kind, id, info), nil
+}
+
+// parseParentLimits reads the limits from an info response. Projects and
+// organizations keep their budget and rate limits in litellm_budget_table,
+// so that is consulted when a limit is missing at the top level.
+func parseParentLimits(kind, id string, info map[string]interface{}) *parentLimits {
+	limits := &parentLimits{Kind: kind, ID: id}
+	budgetTable, _ := info["litellm_budget_table"].(map[string]interface{})
+
+	number := func(field string) (float64, bool) {
+		if v, ok := info[field].(float64); ok {
+			return v, true
+		}
+		v, ok := budgetTable[field].(float64)
+		return v, ok
+	}
+
+	if v, ok := number("max_budget"); ok {
+		limits.MaxBudget = &v
+	}
+	if v, ok := number("tpm_limit"); ok {
+		n := int64(v)
+		limits.TPMLimit = &n
+	}
+	if v, ok := number("rpm_limit"); ok {
+		n := int64(v)
+		limits.RPMLimit = &n
+	}
+	if models, ok := info["models"].([]interface{}); ok {
+		for _, m := range models {
+			if s, ok := m.(string); ok {
+				limits.Models = append(limits.Models, s)
+			}
+		}
+	}
+
+	return limits
+}
+
+// allowsModel reports whether model is within the parent's model list. An
+// empty list or all-proxy-models allows every model, and entries ending in
+// "*" match by prefix.
+func (p *parentLimits) allowsModel(model string) bool {
+	if len(p.Models) == 0 {
+		return true
+	}
+	for _, m := range p.Models {
+		if m == model || m == "all-proxy-models" {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(m, "*"); ok && strings.HasPrefix(model, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkParentLimits reports configured limits that exceed the parent's.
+// Budgets and rate limits above the parent's are errors because LiteLLM
+// rejects them. Models outside the parent's list are only warnings since
+// they may still be granted through an access group.
+func checkParentLimits(ctx context.Context, diags *diag.Diagnostics, parent *parentLimits, child childLimits) {
+	if parent.MaxBudget != nil && !child.MaxBudget.IsNull() && !child.MaxBudget.IsUnknown() && child.MaxBudget.ValueFloat64() > *parent.MaxBudget {
+		diags.AddAttributeError(path.Root("max_budget"), "Limit Exceeds Parent",
+			fmt.Sprintf("max_budget %g exceeds the max_budget %g of %s %q.", child.MaxBudget.ValueFloat64(), *parent.MaxBudget, parent.Kind, parent.ID))
+	}
+	if parent.TPMLimit != nil && !child.TPMLimit.IsNull() && !child.TPMLimit.IsUnknown() && child.TPMLimit.ValueInt64() > *parent.TPMLimit {
+		diags.AddAttributeError(path.Root("tpm_limit"), "Limit Exceeds Parent",
+			fmt.Sprintf("tpm_limit %d exceeds the tpm_limit %d of %s %q.", child.TPMLimit.ValueInt64(), *parent.TPMLimit, parent.Kind, parent.ID))
+	}
+	if parent.RPMLimit != nil && !child.RPMLimit.IsNull() && !child.RPMLimit.IsUnknown() && child.RPMLimit.ValueInt64() > *parent.RPMLimit {
+		diags.AddAttributeError(path.Root("rpm_limit"), "Limit Exceeds Parent",
+			fmt.Sprintf("rpm_limit %d exceeds the rpm_limit %d of %s %q.", child.RPMLimit.ValueInt64(), *parent.RPMLimit, parent.Kind, parent.ID))
+	}
+
+	if child.Models.IsNull() || child.Models.IsUnknown() {
+		return
+	}
+	var models []string
+	diags.Append(child.Models.ElementsAs(ctx, &models, false)...)
+
+	var outside []string
+	for _, model := range models {
+		// all-team-models is resolved by LiteLLM from the team itself.
+		if model == "all-team-models" || parent.allowsModel(model) {
+			continue
+		}
+		outside = append(outside, model)
+	}
+	if len(outside) > 0 {
+		diags.AddAttributeWarning(path.Root("models"), "Models Outside Parent",
+			fmt.Sprintf("%s %q does not list %s. Unless they are granted through an access group, requests for them will be rejected.",
+				parent.Kind, parent.ID, strings.Join(outside, ", ")))
+	}
+}
+
+// planParentLimits checks the configured models, max_budget, tpm_limit and
+// rpm_limit against the parent named by parentAttr. The check only runs
+// when one of them or the parent changes, since LiteLLM validates limits
+// when they are written. It is skipped while the parent ID is unknown, and a
+// failed lookup only warns so planning does not depend on reading the parent.
+func (c *Client) planParentLimits(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, kind, parentAttr string) {
+	if c == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var parentID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(parentAttr), &parentID)...)
+	if resp.Diagnostics.HasError() || parentID.IsNull() || parentID.IsUnknown() || parentID.ValueString() == "" {
+		return
+	}
+
+	var child childLimits
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("models"), &child.Models)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_budget"), &child.MaxBudget)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tpm_limit"), &child.TPMLimit)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rpm_limit"), &child.RPMLimit)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateParentID types.String
+		var state childLimits
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(parentAttr), &stateParentID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("models"), &state.Models)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("max_budget"), &state.MaxBudget)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tpm_limit"), &state.TPMLimit)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rpm_limit"), &state.RPMLimit)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !configChanged(parentID, stateParentID) && !configChanged(child.Models, state.Models) &&
+			!configChanged(child.MaxBudget, state.MaxBudget) && !configChanged(child.TPMLimit, state.TPMLimit) &&
+			!configChanged(child.RPMLimit, state.RPMLimit) {
+			return
+		}
+	}
+
+	parent, err := c.getParentLimits(ctx, kind, parentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Check Parent Limits",
+			fmt.Sprintf("Could not read %s %q to check this resource's limits against it: %s", kind, parentID.ValueString(), err))
+		return
+	}
+
+	checkParentLimits(ctx, &resp.Diagnostics, parent, child)
+}
+
+// configChanged reports whether a configured value differs from state.
+// Unset values are left to the API and never count as a change.
+func configChanged(config, state attr.Value) bool {
+	return !config.IsNull() && !config.Equal(state)
+}
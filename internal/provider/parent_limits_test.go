@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGetParentLimitsReadsBudgetTable(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organization/info" || r.URL.Query().Get("organization_id") != "org-1" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"organization_id": "org-1",
+			"models":          []interface{}{"gpt-4o"},
+			"litellm_budget_table": map[string]interface{}{
+				"max_budget": 100.0,
+				"tpm_limit":  1000,
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+	limits, err := client.getParentLimits(context.Background(), "organization", "org-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limits.MaxBudget == nil || *limits.MaxBudget != 100 || limits.TPMLimit == nil || *limits.TPMLimit != 1000 {
+		t.Fatalf("expected limits from litellm_budget_table, got %+v", limits)
+	}
+	if limits.RPMLimit != nil {
+		t.Fatalf("expected no rpm_limit, got %d", *limits.RPMLimit)
+	}
+	if len(limits.Models) != 1 || limits.Models[0] != "gpt-4o" {
+		t.Fatalf("unexpected models: %v", limits.Models)
+	}
+}
+
+func TestCheckParentLimits(t *testing.T) {
+	t.Parallel()
+
+	maxBudget := 50.0
+	rpmLimit := int64(10)
+	parent := &parentLimits{
+		Kind:      "team",
+		ID:        "team-1",
+		Models:    []string{"gpt-4o", "anthropic/*"},
+		MaxBudget: &maxBudget,
+		RPMLimit:  &rpmLimit,
+	}
+	models := func(names ...string) types.List {
+		values := make([]attr.Value, len(names))
+		for i, name := range names {
+			values[i] = types.StringValue(name)
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	var diags diag.Diagnostics
+	checkParentLimits(context.Background(), &diags, parent, childLimits{
+		Models:    models("gpt-4o", "anthropic/claude", "all-team-models"),
+		MaxBudget: types.Float64Value(50),
+		TPMLimit:  types.Int64Value(100000),
+		RPMLimit:  types.Int64Value(10),
+	})
+	if len(diags) != 0 {
+		t.Fatalf("expected limits within the parent to pass, got %v", diags)
+	}
+
+	diags = nil
+	checkParentLimits(context.Background(), &diags, parent, childLimits{
+		Models:    models("gpt-4o", "gemini-pro"),
+		MaxBudget: types.Float64Value(75),
+		TPMLimit:  types.Int64Null(),
+		RPMLimit:  types.Int64Value(20),
+	})
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected max_budget and rpm_limit errors, got %v", diags)
+	}
+	if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "gemini-pro") {
+		t.Fatalf("expected a warning naming gemini-pro, got %v", diags)
+	}
+}
+
+func TestCheckParentLimitsUnrestrictedParent(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+	checkParentLimits(context.Background(), &diags, &parentLimits{Kind: "project", ID: "project-1"}, childLimits{
+		Models:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("gpt-4o")}),
+		MaxBudget: types.Float64Value(1000),
+		TPMLimit:  types.Int64Value(1000),
+		RPMLimit:  types.Int64Value(1000),
+	})
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics for a parent without limits, got %v", diags)
+	}
+}
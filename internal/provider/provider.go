@@ -2,20 +2,35 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
+	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure LiteLLMProvider satisfies various provider interfaces.
 var _ provider.Provider = &LiteLLMProvider{}
+var _ provider.ProviderWithEphemeralResources = &LiteLLMProvider{}
+var _ provider.ProviderWithFunctions = &LiteLLMProvider{}
+var _ provider.ProviderWithListResources = &LiteLLMProvider{}
+var _ provider.ProviderWithActions = &LiteLLMProvider{}
 
 // LiteLLMProvider defines the provider implementation.
 type LiteLLMProvider struct {
@@ -31,6 +46,34 @@ type LiteLLMProviderModel struct {
 	APIKey             types.String `tfsdk:"api_key"`
 	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 	LiteLLMChangedBy   types.String `tfsdk:"litellm_changed_by"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait       types.Int64  `tfsdk:"retry_min_wait"`
+	RetryMaxWait       types.Int64  `tfsdk:"retry_max_wait"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	DefaultMetadata    types.Map    `tfsdk:"default_metadata"`
+	DefaultTags        types.List   `tfsdk:"default_tags"`
+	Auth               *AuthModel   `tfsdk:"auth"`
+}
+
+// AuthModel describes the optional auth block, which replaces the static
+// api_key with a dynamically obtained credential.
+type AuthModel struct {
+	APIKeyCommand           types.List                    `tfsdk:"api_key_command"`
+	OAuth2ClientCredentials *OAuth2ClientCredentialsModel `tfsdk:"oauth2_client_credentials"`
+}
+
+// OAuth2ClientCredentialsModel describes the auth.oauth2_client_credentials block.
+type OAuth2ClientCredentialsModel struct {
+	TokenURL     types.String `tfsdk:"token_url"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	Audience     types.String `tfsdk:"audience"`
 }
 
 // Client holds the HTTP client and configuration for API calls.
@@ -39,6 +82,27 @@ type Client struct {
 	APIKey           string
 	LiteLLMChangedBy string
 	HTTPClient       *http.Client
+
+	// Credentials, when set, supplies the credential instead of APIKey.
+	Credentials credentialSource
+
+	// Provider-wide metadata and tags merged into every managed object.
+	DefaultMetadata map[string]string
+	DefaultTags     []string
+
+	// ServerInfo describes the connected proxy. When it is nil, probe
+	// detects the proxy on first use; see serverInfo.
+	ServerInfo *ServerInfo
+	probe      *serverProbe
+
+	// RequestTimeout bounds a single HTTP request made outside an operation
+	// deadline.
+	RequestTimeout time.Duration
+
+	// Retry policy for transient failures (HTTP 429 and 5xx).
+	MaxRetries   int
+	RetryMinWait time.Duration
+	RetryMaxWait time.Duration
 }
 
 func (p *LiteLLMProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -67,6 +131,121 @@ func (p *LiteLLMProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Description: "Value for the litellm-changed-by header to track actions performed by authorized users.",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request is retried after a transient failure (HTTP 429 or 5xx; requests that create objects only on 429 and 503). Defaults to 3. Set to 0 to disable retries.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.Int64Attribute{
+				Description: "Minimum time in seconds to wait before retrying a failed request. The wait doubles on each attempt. Defaults to 1.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait between retries, including waits requested by a Retry-After header. Defaults to 30.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Timeout in seconds for a single HTTP request to the LiteLLM API. Defaults to 30. Can also be set via the LITELLM_REQUEST_TIMEOUT environment variable. A resource's timeouts block bounds the whole operation, not a single request.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate bundle used to verify the LiteLLM API certificate, in addition to the system roots. Conflicts with ca_cert_file. Can also be set via the LITELLM_CA_CERT_PEM environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM-encoded CA certificate bundle used to verify the LiteLLM API certificate, in addition to the system roots. Conflicts with ca_cert_pem. Can also be set via the LITELLM_CA_CERT_FILE environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded client certificate for mutual TLS. Requires client_key_pem. Can also be set via the LITELLM_CLIENT_CERT_PEM environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM-encoded private key for the mutual TLS client certificate. Requires client_cert_pem. Can also be set via the LITELLM_CLIENT_KEY_PEM environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of an HTTP proxy used for all requests to the LiteLLM API (e.g. http://proxy.internal:3128). Can also be set via the LITELLM_PROXY_URL environment variable.",
+				Optional:    true,
+			},
+			"default_metadata": schema.MapAttribute{
+				Description: "Metadata merged into every key, team, user, organization, project and model (model_info) managed by the provider. Metadata set on a resource takes precedence.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"default_tags": schema.ListAttribute{
+				Description: "Tags added to every key, team, organization and project managed by the provider.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth": schema.SingleNestedBlock{
+				Description: "Alternative authentication used instead of a static api_key. Exactly one of api_key_command or oauth2_client_credentials must be set.",
+				Attributes: map[string]schema.Attribute{
+					"api_key_command": schema.ListAttribute{
+						Description: "Command and arguments of a credential helper whose standard output is used as the API key (e.g. [\"vault\", \"kv\", \"get\", \"-field=key\", \"secret/litellm\"]). The command runs once per provider invocation.",
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"oauth2_client_credentials": schema.SingleNestedBlock{
+						Description: "Obtain a JWT access token using the OAuth2 client-credentials grant. The token is sent as an Authorization bearer token and refreshed before it expires.",
+						Attributes: map[string]schema.Attribute{
+							"token_url": schema.StringAttribute{
+								Description: "Token endpoint of the identity provider.",
+								Optional:    true,
+							},
+							"client_id": schema.StringAttribute{
+								Description: "OAuth2 client ID.",
+								Optional:    true,
+							},
+							"client_secret": schema.StringAttribute{
+								Description: "OAuth2 client secret. Can also be set via the LITELLM_OAUTH_CLIENT_SECRET environment variable.",
+								Optional:    true,
+								Sensitive:   true,
+							},
+							"scopes": schema.ListAttribute{
+								Description: "Scopes to request.",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"audience": schema.StringAttribute{
+								Description: "Audience to request, for identity providers that require one.",
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -98,11 +277,17 @@ func (p *LiteLLMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
-	if apiKey == "" {
+	defaultMetadata, defaultTags, diags := defaultsFromConfig(ctx, config)
+	resp.Diagnostics.Append(diags...)
+
+	credentials, diags := buildCredentialSource(ctx, config)
+	resp.Diagnostics.Append(diags...)
+
+	if apiKey == "" && credentials == nil && !diags.HasError() {
 		resp.Diagnostics.AddError(
 			"Missing API Key",
 			"The provider cannot create the LiteLLM API client as there is a missing or empty value for the LiteLLM API key. "+
-				"Set the api_key value in the configuration or use the LITELLM_API_KEY environment variable.",
+				"Set the api_key value in the configuration, use the LITELLM_API_KEY environment variable, or configure an auth block.",
 		)
 	}
 
@@ -121,23 +306,183 @@ func (p *LiteLLMProvider) Configure(ctx context.Context, req provider.ConfigureR
 		litellmChangedBy = config.LiteLLMChangedBy.ValueString()
 	}
 
-	// Create HTTP client with TLS configuration
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retryMinWait := defaultRetryMinWait
+	if !config.RetryMinWait.IsNull() {
+		retryMinWait = time.Duration(config.RetryMinWait.ValueInt64()) * time.Second
+	}
+
+	retryMaxWait := defaultRetryMaxWait
+	if !config.RetryMaxWait.IsNull() {
+		retryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	if retryMaxWait < retryMinWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Invalid Retry Configuration",
+			"retry_max_wait must be greater than or equal to retry_min_wait.",
+		)
+		return
+	}
+
+	requestTimeout := defaultRequestTimeout
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	} else if v := os.Getenv("LITELLM_REQUEST_TIMEOUT"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds < 1 {
+			resp.Diagnostics.AddError(
+				"Invalid Request Timeout",
+				fmt.Sprintf("LITELLM_REQUEST_TIMEOUT must be a positive number of seconds, got %q.", v),
+			)
+			return
+		}
+		requestTimeout = time.Duration(seconds) * time.Second
+	}
+
+	// Create HTTP client with TLS and proxy configuration
+	tr, err := buildHTTPTransport(transportConfig{
+		InsecureSkipVerify: insecureSkipVerify,
+		CACertPEM:          stringConfigOrEnv(config.CACertPEM, "LITELLM_CA_CERT_PEM"),
+		CACertFile:         stringConfigOrEnv(config.CACertFile, "LITELLM_CA_CERT_FILE"),
+		ClientCertPEM:      stringConfigOrEnv(config.ClientCertPEM, "LITELLM_CLIENT_CERT_PEM"),
+		ClientKeyPEM:       stringConfigOrEnv(config.ClientKeyPEM, "LITELLM_CLIENT_KEY_PEM"),
+		ProxyURL:           stringConfigOrEnv(config.ProxyURL, "LITELLM_PROXY_URL"),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Transport Configuration",
+			fmt.Sprintf("The provider cannot create the LiteLLM API client: %s", err),
+		)
+		return
+	}
+
+	// The request timeout is applied per attempt by the client, which also
+	// caps it by the operation's deadline.
+	httpClient := &http.Client{
+		Transport: tr,
+	}
+
+	// The token endpoint is reached through the same TLS and proxy settings
+	// as the LiteLLM API.
+	if oauth, ok := credentials.(*oauth2ClientCredentialsSource); ok {
+		oauth.HTTPClient = &http.Client{
+			Transport: tr,
+			Timeout:   requestTimeout,
+		}
 	}
 
 	client := &Client{
 		APIBase:          apiBase,
 		APIKey:           apiKey,
 		LiteLLMChangedBy: litellmChangedBy,
-		HTTPClient: &http.Client{
-			Transport: tr,
-			Timeout:   30 * time.Second,
-		},
+		HTTPClient:       httpClient,
+		RequestTimeout:   requestTimeout,
+		Credentials:      credentials,
+		DefaultMetadata:  defaultMetadata,
+		DefaultTags:      defaultTags,
+		MaxRetries:       maxRetries,
+		RetryMinWait:     retryMinWait,
+		RetryMaxWait:     retryMaxWait,
+		probe:            &serverProbe{},
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
+	resp.ActionData = client
+}
+
+// buildCredentialSource validates the auth block and returns the credential
+// source it describes, or nil when the static api_key should be used.
+func buildCredentialSource(ctx context.Context, config LiteLLMProviderModel) (credentialSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.Auth == nil {
+		return nil, diags
+	}
+
+	hasCommand := !config.Auth.APIKeyCommand.IsNull()
+	oauth := config.Auth.OAuth2ClientCredentials
+	if hasCommand == (oauth != nil) {
+		diags.AddAttributeError(
+			path.Root("auth"),
+			"Invalid Auth Configuration",
+			"Exactly one of api_key_command or oauth2_client_credentials must be set in the auth block.",
+		)
+		return nil, diags
+	}
+
+	if !config.APIKey.IsNull() {
+		diags.AddAttributeError(
+			path.Root("api_key"),
+			"Conflicting Authentication Configuration",
+			"api_key cannot be set together with an auth block. Remove one of them.",
+		)
+		return nil, diags
+	}
+
+	if hasCommand {
+		var command []string
+		diags.Append(config.Auth.APIKeyCommand.ElementsAs(ctx, &command, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if command[0] == "" {
+			diags.AddAttributeError(
+				path.Root("auth").AtName("api_key_command"),
+				"Invalid Auth Configuration",
+				"The first element of api_key_command must be the command to run.",
+			)
+			return nil, diags
+		}
+		return &commandCredentialSource{Command: command}, diags
+	}
+
+	oauthPath := path.Root("auth").AtName("oauth2_client_credentials")
+	source := &oauth2ClientCredentialsSource{
+		TokenURL:     oauth.TokenURL.ValueString(),
+		ClientID:     oauth.ClientID.ValueString(),
+		ClientSecret: stringConfigOrEnv(oauth.ClientSecret, "LITELLM_OAUTH_CLIENT_SECRET"),
+		Audience:     oauth.Audience.ValueString(),
+	}
+	required := []struct{ name, value string }{
+		{"token_url", source.TokenURL},
+		{"client_id", source.ClientID},
+		{"client_secret", source.ClientSecret},
+	}
+	for _, attr := range required {
+		if attr.value == "" {
+			diags.AddAttributeError(
+				oauthPath.AtName(attr.name),
+				"Invalid Auth Configuration",
+				fmt.Sprintf("%s is required for the OAuth2 client-credentials flow.", attr.name),
+			)
+		}
+	}
+	if !oauth.Scopes.IsNull() {
+		diags.Append(oauth.Scopes.ElementsAs(ctx, &source.Scopes, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return source, diags
+}
+
+// stringConfigOrEnv returns the configured value of v, falling back to the
+// named environment variable when v is null.
+func stringConfigOrEnv(v types.String, envVar string) string {
+	if !v.IsNull() {
+		return v.ValueString()
+	}
+	return os.Getenv(envVar)
 }
 
 func (p *LiteLLMProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -149,12 +494,16 @@ func (p *LiteLLMProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewTeamBlockResource,
 		NewTeamMemberResource,
 		NewTeamMemberAddResource,
+		NewTeamCallbackResource,
+		NewTeamModelResource,
 		NewMCPServerResource,
 		NewCredentialResource,
 		NewVectorStoreResource,
 		NewOrganizationResource,
 		NewOrganizationMemberResource,
 		NewUserResource,
+		NewCustomerResource,
+		NewJWTKeyMappingResource,
 		NewBudgetResource,
 		NewTagResource,
 		NewAccessGroupResource,
@@ -168,6 +517,44 @@ func (p *LiteLLMProvider) Resources(ctx context.Context) []func() resource.Resou
 	}
 }
 
+func (p *LiteLLMProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKeyEphemeralResource,
+		NewCredentialEphemeralResource,
+	}
+}
+
+func (p *LiteLLMProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewKeyListResource,
+		NewTeamListResource,
+		NewUserListResource,
+		NewModelListResource,
+		NewMCPServerListResource,
+	}
+}
+
+func (p *LiteLLMProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewKeyRegenerateAction,
+		NewKeyResetSpendAction,
+		NewTeamBlockAction,
+		NewCacheFlushAction,
+		NewGlobalSpendResetAction,
+	}
+}
+
+func (p *LiteLLMProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCostPerTokenToPerMillionFunction,
+		NewParseDurationFunction,
+		NewModelRouteFunction,
+		NewParseModelRouteFunction,
+		NewNormalizeNumericFunction,
+		NewParamValueFunction,
+	}
+}
+
 func (p *LiteLLMProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		// Single item lookups
@@ -178,6 +565,7 @@ func (p *LiteLLMProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewVectorStoreDataSource,
 		NewOrganizationDataSource,
 		NewUserDataSource,
+		NewCustomerDataSource,
 		NewBudgetDataSource,
 		NewTagDataSource,
 		NewAccessGroupDataSource,
@@ -189,12 +577,15 @@ func (p *LiteLLMProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewFallbackDataSource,
 		NewAgentDataSource,
 		NewProjectDataSource,
+		NewServerInfoDataSource,
 		// List data sources
 		NewModelsListDataSource,
 		NewKeysListDataSource,
 		NewTeamsListDataSource,
 		NewOrganizationsListDataSource,
 		NewUsersListDataSource,
+		NewCustomersListDataSource,
+		NewJWTKeyMappingsListDataSource,
 		NewBudgetsListDataSource,
 		NewTagsListDataSource,
 		NewAccessGroupsListDataSource,
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The provider's default_metadata and default_tags are merged into the
+// metadata and tags sent for every key, team, user, organization, project
+// and model. Values configured on the resource take precedence. The
+// resource's own metadata and tags attributes keep tracking only what was
+// configured on the resource, while the computed metadata_all and tags_all
+// attributes show the merged result.
+
+// metadataAllSchemaAttribute returns the computed metadata_all attribute.
+func metadataAllSchemaAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: "Metadata applied to the object, including the provider's default_metadata.",
+		Computed:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// tagsAllSchemaAttribute returns the computed tags_all attribute.
+func tagsAllSchemaAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "Tags applied to the object, including the provider's default_tags.",
+		Computed:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// mergeDefaultMetadata adds the provider's default_metadata to the metadata
+// of a request body. Keys already present are left unchanged.
+func (c *Client) mergeDefaultMetadata(body map[string]interface{}) {
+	if c == nil || len(c.DefaultMetadata) == 0 {
+		return
+	}
+	metadata := make(map[string]interface{}, len(c.DefaultMetadata))
+	switch m := body["metadata"].(type) {
+	case map[string]interface{}:
+		metadata = m
+	case map[string]string:
+		for k, v := range m {
+			metadata[k] = v
+		}
+	}
+	for k, v := range convertMetadataToNative(c.DefaultMetadata) {
+		if _, ok := metadata[k]; !ok {
+			metadata[k] = v
+		}
+	}
+	body["metadata"] = metadata
+}
+
+// mergeDefaultModelInfo adds the provider's default_metadata to a model's
+// model_info, which has no separate metadata field. Fields already present
+// are left unchanged.
+func (c *Client) mergeDefaultModelInfo(modelInfo map[string]interface{}) {
+	if c == nil {
+		return
+	}
+	for k, v := range convertMetadataToNative(c.DefaultMetadata) {
+		if _, ok := modelInfo[k]; !ok {
+			modelInfo[k] = v
+		}
+	}
+}
+
+// mergeDefaultTags appends the provider's default_tags that are not already
+// present to the tags of a request body.
+func (c *Client) mergeDefaultTags(body map[string]interface{}) {
+	if c == nil || len(c.DefaultTags) == 0 {
+		return
+	}
+	tags, _ := body["tags"].([]string)
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		seen[t] = true
+	}
+	for _, t := range c.DefaultTags {
+		if !seen[t] {
+			tags = append(tags, t)
+			seen[t] = true
+		}
+	}
+	body["tags"] = tags
+}
+
+// withoutDefaultMetadata returns apiMetadata without the keys that are only
+// present because of default_metadata, so they do not show up as drift in
+// the resource's metadata attribute.
+func (c *Client) withoutDefaultMetadata(ctx context.Context, apiMetadata map[string]interface{}, configured types.Map) map[string]interface{} {
+	if c == nil || len(c.DefaultMetadata) == 0 || apiMetadata == nil {
+		return apiMetadata
+	}
+	configuredKeys := make(map[string]string)
+	if !configured.IsNull() && !configured.IsUnknown() {
+		configured.ElementsAs(ctx, &configuredKeys, false)
+	}
+	result := make(map[string]interface{}, len(apiMetadata))
+	for k, v := range apiMetadata {
+		if _, isDefault := c.DefaultMetadata[k]; isDefault {
+			if _, isConfigured := configuredKeys[k]; !isConfigured {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// withoutDefaultTags returns apiTags without the tags that are only present
+// because of default_tags.
+func (c *Client) withoutDefaultTags(ctx context.Context, apiTags []interface{}, configured types.List) []interface{} {
+	if c == nil || len(c.DefaultTags) == 0 || apiTags == nil {
+		return apiTags
+	}
+	configuredTags := make(map[string]bool)
+	if !configured.IsNull() && !configured.IsUnknown() {
+		var tags []string
+		configured.ElementsAs(ctx, &tags, false)
+		for _, t := range tags {
+			configuredTags[t] = true
+		}
+	}
+	defaults := make(map[string]bool, len(c.DefaultTags))
+	for _, t := range c.DefaultTags {
+		defaults[t] = true
+	}
+	result := make([]interface{}, 0, len(apiTags))
+	for _, t := range apiTags {
+		if s, ok := t.(string); ok && defaults[s] && !configuredTags[s] {
+			continue
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+// plannedMetadataAll returns the expected metadata_all for the planned
+// metadata, or an unknown value while the metadata is not yet known.
+func (c *Client) plannedMetadataAll(ctx context.Context, metadata types.Map) types.Map {
+	if metadata.IsUnknown() {
+		return types.MapUnknown(types.StringType)
+	}
+	merged := make(map[string]attr.Value)
+	if c != nil {
+		for k, v := range c.DefaultMetadata {
+			merged[k] = types.StringValue(v)
+		}
+	}
+	if !metadata.IsNull() {
+		for k, v := range metadata.Elements() {
+			merged[k] = v
+		}
+	}
+	result, _ := types.MapValue(types.StringType, merged)
+	return result
+}
+
+// plannedTagsAll returns the expected tags_all for the planned tags, or an
+// unknown value while the tags are not yet known.
+func (c *Client) plannedTagsAll(ctx context.Context, tags types.List) types.List {
+	if tags.IsUnknown() {
+		return types.ListUnknown(types.StringType)
+	}
+	var merged []string
+	if !tags.IsNull() {
+		tags.ElementsAs(ctx, &merged, false)
+	}
+	return c.appendDefaultTags(merged, nil)
+}
+
+// metadataAllFromAPI returns metadata_all after a read: the resource's
+// metadata plus every default_metadata key the API still reports. A default
+// key missing from, or changed on, the server therefore shows up as a diff
+// against the planned value.
+func (c *Client) metadataAllFromAPI(ctx context.Context, metadata types.Map, apiMetadata map[string]interface{}) types.Map {
+	merged := make(map[string]attr.Value)
+	if c != nil {
+		defaults := convertMetadataToNative(c.DefaultMetadata)
+		for k, v := range c.DefaultMetadata {
+			apiValue, ok := apiMetadata[k]
+			if !ok {
+				continue
+			}
+			if metadataValueToString(apiValue) == metadataValueToString(defaults[k]) {
+				merged[k] = types.StringValue(v)
+			} else {
+				merged[k] = types.StringValue(metadataValueToString(apiValue))
+			}
+		}
+	}
+	if !metadata.IsNull() && !metadata.IsUnknown() {
+		for k, v := range metadata.Elements() {
+			merged[k] = v
+		}
+	}
+	result, _ := types.MapValue(types.StringType, merged)
+	return result
+}
+
+// tagsAllFromAPI returns tags_all after a read: the resource's tags plus
+// every default tag the API still reports.
+func (c *Client) tagsAllFromAPI(ctx context.Context, tags types.List, apiTags []interface{}) types.List {
+	var merged []string
+	if !tags.IsNull() && !tags.IsUnknown() {
+		tags.ElementsAs(ctx, &merged, false)
+	}
+	present := make(map[string]bool, len(apiTags))
+	for _, t := range apiTags {
+		if s, ok := t.(string); ok {
+			present[s] = true
+		}
+	}
+	return c.appendDefaultTags(merged, present)
+}
+
+// appendDefaultTags appends the default tags missing from tags, restricted
+// to those in present when present is non-nil.
+func (c *Client) appendDefaultTags(tags []string, present map[string]bool) types.List {
+	seen := make(map[string]bool, len(tags))
+	values := make([]attr.Value, 0, len(tags))
+	for _, t := range tags {
+		seen[t] = true
+		values = append(values, types.StringValue(t))
+	}
+	if c != nil {
+		for _, t := range c.DefaultTags {
+			if seen[t] || (present != nil && !present[t]) {
+				continue
+			}
+			seen[t] = true
+			values = append(values, types.StringValue(t))
+		}
+	}
+	result, _ := types.ListValue(types.StringType, values)
+	return result
+}
+
+// planDefaults sets metadata_all, and tags_all when withTags is true, in the
+// plan from the planned metadata and tags merged with the provider defaults.
+// Resources without a metadata attribute pass an empty metadataAttr.
+func (c *Client) planDefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, metadataAttr string, withTags bool) {
+	if c == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	metadata := types.MapNull(types.StringType)
+	if metadataAttr != "" {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(metadataAttr), &metadata)...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("metadata_all"), c.plannedMetadataAll(ctx, metadata))...)
+
+	if withTags {
+		var tags types.List
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), c.plannedTagsAll(ctx, tags))...)
+	}
+}
+
+// defaultsFromConfig reads default_metadata and default_tags from the
+// provider configuration.
+func defaultsFromConfig(ctx context.Context, config LiteLLMProviderModel) (map[string]string, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var metadata map[string]string
+	var tags []string
+	if !config.DefaultMetadata.IsNull() && !config.DefaultMetadata.IsUnknown() {
+		diags.Append(config.DefaultMetadata.ElementsAs(ctx, &metadata, false)...)
+	}
+	if !config.DefaultTags.IsNull() && !config.DefaultTags.IsUnknown() {
+		diags.Append(config.DefaultTags.ElementsAs(ctx, &tags, false)...)
+	}
+	return metadata, tags, diags
+}
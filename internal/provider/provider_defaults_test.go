@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testDefaultsClient() *Client {
+	return &Client{
+		DefaultMetadata: map[string]string{"owner": "platform", "cost_center": "cc-42", "managed_by": "terraform"},
+		DefaultTags:     []string{"terraform"},
+	}
+}
+
+func TestBuildKeyRequest_mergesProviderDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &KeyResource{client: testDefaultsClient()}
+	data := KeyResourceModel{
+		Metadata: types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("ml-team")}),
+		Tags:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+	}
+
+	keyReq := r.buildKeyRequest(ctx, &data)
+
+	wantMetadata := map[string]interface{}{"owner": "ml-team", "cost_center": "cc-42", "managed_by": "terraform"}
+	if !reflect.DeepEqual(keyReq["metadata"], wantMetadata) {
+		t.Errorf("metadata = %v, want %v", keyReq["metadata"], wantMetadata)
+	}
+	if !reflect.DeepEqual(keyReq["tags"], []string{"prod", "terraform"}) {
+		t.Errorf("tags = %v, want [prod terraform]", keyReq["tags"])
+	}
+}
+
+func TestBuildUserRequest_mergesDefaultMetadataIntoStringMap(t *testing.T) {
+	t.Parallel()
+
+	r := &UserResource{client: testDefaultsClient()}
+	body := map[string]interface{}{"metadata": map[string]string{"owner": "ml-team"}}
+	r.client.mergeDefaultMetadata(body)
+
+	want := map[string]interface{}{"owner": "ml-team", "cost_center": "cc-42", "managed_by": "terraform"}
+	if !reflect.DeepEqual(body["metadata"], want) {
+		t.Errorf("metadata = %v, want %v", body["metadata"], want)
+	}
+}
+
+func TestReadKey_separatesProviderDefaults(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"key": "sk-1",
+			"info": map[string]interface{}{
+				// managed_by was removed out of band.
+				"metadata": map[string]interface{}{"owner": "ml-team", "cost_center": "cc-42", "tpm_limit_type": "key"},
+				"tags":     []interface{}{"prod", "terraform"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := testDefaultsClient()
+	client.APIBase = server.URL
+	client.HTTPClient = server.Client()
+	r := &KeyResource{client: client}
+
+	ctx := context.Background()
+	data := KeyResourceModel{
+		ID:       types.StringValue("hash"),
+		Key:      types.StringValue("sk-1"),
+		Metadata: types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringValue("ml-team")}),
+		Tags:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+	}
+	if err := r.readKey(ctx, &data); err != nil {
+		t.Fatalf("readKey: %v", err)
+	}
+
+	if got := data.Metadata.Elements(); len(got) != 1 || got["owner"] != types.StringValue("ml-team") {
+		t.Errorf("metadata = %v, want only the configured owner key", data.Metadata)
+	}
+	if got := data.Tags.Elements(); len(got) != 1 || got[0] != types.StringValue("prod") {
+		t.Errorf("tags = %v, want [prod]", data.Tags)
+	}
+
+	wantAll := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"owner":       types.StringValue("ml-team"),
+		"cost_center": types.StringValue("cc-42"),
+	})
+	if !data.MetadataAll.Equal(wantAll) {
+		t.Errorf("metadata_all = %v, want %v", data.MetadataAll, wantAll)
+	}
+	wantTagsAll := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod"), types.StringValue("terraform")})
+	if !data.TagsAll.Equal(wantTagsAll) {
+		t.Errorf("tags_all = %v, want %v", data.TagsAll, wantTagsAll)
+	}
+
+	// The missing managed_by default must show up as a planned change.
+	planned := client.plannedMetadataAll(ctx, data.Metadata)
+	if planned.Equal(data.MetadataAll) {
+		t.Error("planned metadata_all should differ from state when a default key is missing on the server")
+	}
+	if !client.plannedTagsAll(ctx, data.Tags).Equal(data.TagsAll) {
+		t.Error("planned tags_all should match state when all default tags are present")
+	}
+}
+
+func TestPlannedDefaults_withoutProviderDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var client *Client
+
+	metadata := types.MapValueMust(types.StringType, map[string]attr.Value{"a": types.StringValue("b")})
+	if got := client.plannedMetadataAll(ctx, metadata); !got.Equal(metadata) {
+		t.Errorf("plannedMetadataAll = %v, want %v", got, metadata)
+	}
+	if got := client.plannedMetadataAll(ctx, types.MapUnknown(types.StringType)); !got.IsUnknown() {
+		t.Errorf("plannedMetadataAll(unknown) = %v, want unknown", got)
+	}
+	if got := client.plannedTagsAll(ctx, types.ListNull(types.StringType)); got.IsNull() || len(got.Elements()) != 0 {
+		t.Errorf("plannedTagsAll(null) = %v, want empty list", got)
+	}
+}
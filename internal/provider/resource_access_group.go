@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,9 +26,10 @@ type AccessGroupResource struct {
 }
 
 type AccessGroupResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	AccessGroup types.String `tfsdk:"access_group"`
-	ModelNames  types.List   `tfsdk:"model_names"`
+	ID          types.String   `tfsdk:"id"`
+	AccessGroup types.String   `tfsdk:"access_group"`
+	ModelNames  types.List     `tfsdk:"model_names"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *AccessGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,6 +60,9 @@ func (r *AccessGroupResource) Schema(ctx context.Context, req resource.SchemaReq
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -86,6 +91,9 @@ func (r *AccessGroupResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var modelNames []string
 	data.ModelNames.ElementsAs(ctx, &modelNames, false)
 
@@ -96,7 +104,7 @@ func (r *AccessGroupResource) Create(ctx context.Context, req resource.CreateReq
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/access_group/new", createReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access group: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create access group", err)
 		return
 	}
 
@@ -118,12 +126,15 @@ func (r *AccessGroupResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readAccessGroup(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access group: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read access group", err)
 		return
 	}
 
@@ -138,6 +149,9 @@ func (r *AccessGroupResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state AccessGroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -158,7 +172,7 @@ func (r *AccessGroupResource) Update(ctx context.Context, req resource.UpdateReq
 	endpoint := fmt.Sprintf("/access_group/%s/update", data.AccessGroup.ValueString())
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "PUT", endpoint, updateReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access group: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update access group", err)
 		return
 	}
 
@@ -178,10 +192,13 @@ func (r *AccessGroupResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	endpoint := fmt.Sprintf("/access_group/%s/delete", data.AccessGroup.ValueString())
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access group: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete access group", err)
 			return
 		}
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +17,7 @@ import (
 
 var _ resource.Resource = &AgentResource{}
 var _ resource.ResourceWithImportState = &AgentResource{}
+var _ resource.ResourceWithModifyPlan = &AgentResource{}
 
 func NewAgentResource() resource.Resource {
 	return &AgentResource{}
@@ -85,10 +87,11 @@ type AgentResourceModel struct {
 	StaticHeaders    types.Map                   `tfsdk:"static_headers"`
 	ExtraHeaders     types.List                  `tfsdk:"extra_headers"`
 	// Computed
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
-	CreatedBy types.String `tfsdk:"created_by"`
-	UpdatedBy types.String `tfsdk:"updated_by"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	UpdatedAt types.String   `tfsdk:"updated_at"`
+	CreatedBy types.String   `tfsdk:"created_by"`
+	UpdatedBy types.String   `tfsdk:"updated_by"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *AgentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -162,6 +165,7 @@ func (r *AgentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 			"agent_card": schema.SingleNestedBlock{
 				Description: "The A2A agent card — a self-describing manifest for the agent.",
 				Attributes: map[string]schema.Attribute{
@@ -329,6 +333,13 @@ func (r *AgentResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+func (r *AgentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.client.requireCapability(ctx, &resp.Diagnostics, capabilityAgents, "The litellm_agent resource", path.Empty())
+}
+
 func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data AgentResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -336,11 +347,14 @@ func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	agentReq := r.buildAgentRequest(&data)
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/v1/agents", agentReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create agent: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create agent", err)
 		return
 	}
 
@@ -363,12 +377,15 @@ func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readAgent(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read agent: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read agent", err)
 		return
 	}
 
@@ -382,6 +399,9 @@ func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state AgentResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -393,7 +413,7 @@ func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	endpoint := fmt.Sprintf("/v1/agents/%s", url.PathEscape(data.ID.ValueString()))
 	if err := r.client.DoRequestWithResponse(ctx, "PUT", endpoint, agentReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update agent: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update agent", err)
 		return
 	}
 
@@ -411,10 +431,15 @@ func (r *AgentResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	endpoint := fmt.Sprintf("/v1/agents/%s", url.PathEscape(data.ID.ValueString()))
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete agent: %s", err))
-		return
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete agent", err)
+			return
+		}
 	}
 }
 
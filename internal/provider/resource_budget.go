@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,15 +27,16 @@ type BudgetResource struct {
 }
 
 type BudgetResourceModel struct {
-	ID                  types.String  `tfsdk:"id"`
-	BudgetID            types.String  `tfsdk:"budget_id"`
-	MaxBudget           types.Float64 `tfsdk:"max_budget"`
-	SoftBudget          types.Float64 `tfsdk:"soft_budget"`
-	MaxParallelRequests types.Int64   `tfsdk:"max_parallel_requests"`
-	TPMLimit            types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit            types.Int64   `tfsdk:"rpm_limit"`
-	BudgetDuration      types.String  `tfsdk:"budget_duration"`
-	ModelMaxBudget      types.String  `tfsdk:"model_max_budget"`
+	ID                  types.String   `tfsdk:"id"`
+	BudgetID            types.String   `tfsdk:"budget_id"`
+	MaxBudget           types.Float64  `tfsdk:"max_budget"`
+	SoftBudget          types.Float64  `tfsdk:"soft_budget"`
+	MaxParallelRequests types.Int64    `tfsdk:"max_parallel_requests"`
+	TPMLimit            types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit            types.Int64    `tfsdk:"rpm_limit"`
+	BudgetDuration      types.String   `tfsdk:"budget_duration"`
+	ModelMaxBudget      types.String   `tfsdk:"model_max_budget"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *BudgetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -81,14 +84,20 @@ func (r *BudgetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 			},
 			"budget_duration": schema.StringAttribute{
-				Description: "Duration for budget reset (e.g., '1hr', '1d', '28d', '1mo').",
+				Description: "Duration for budget reset (e.g., '1h', '1d', '28d', '1mo').",
 				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
 			},
 			"model_max_budget": schema.StringAttribute{
 				Description: "JSON string for per-model budget configuration (e.g., '{\"gpt-4o\": {\"max_budget\": 0.01, \"budget_duration\": \"1d\"}}').",
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -117,11 +126,14 @@ func (r *BudgetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	budgetReq := r.buildBudgetRequest(ctx, &data)
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/budget/new", budgetReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create budget: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create budget", err)
 		return
 	}
 
@@ -147,12 +159,15 @@ func (r *BudgetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readBudget(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read budget: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read budget", err)
 		return
 	}
 
@@ -167,6 +182,9 @@ func (r *BudgetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state BudgetResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -181,7 +199,7 @@ func (r *BudgetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	budgetReq["budget_id"] = data.BudgetID.ValueString()
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/budget/update", budgetReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update budget: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update budget", err)
 		return
 	}
 
@@ -201,13 +219,16 @@ func (r *BudgetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"id": data.BudgetID.ValueString(),
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/budget/delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete budget: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete budget", err)
 			return
 		}
 	}
@@ -273,7 +294,7 @@ func (r *BudgetResource) readBudget(ctx context.Context, data *BudgetResourceMod
 	}
 
 	if len(results) == 0 {
-		return fmt.Errorf("budget not found: %s", budgetID)
+		return fmt.Errorf("budget %s: %w", budgetID, errNotFound)
 	}
 
 	result := results[0]
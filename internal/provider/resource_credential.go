@@ -3,14 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,6 +33,10 @@ type CredentialResourceModel struct {
 	ModelID          types.String `tfsdk:"model_id"`
 	CredentialInfo   types.Map    `tfsdk:"credential_info"`
 	CredentialValues types.Map    `tfsdk:"credential_values"`
+
+	CredentialValuesWO        types.Map      `tfsdk:"credential_values_wo"`
+	CredentialValuesWOVersion types.Int64    `tfsdk:"credential_values_wo_version"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *CredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,11 +72,25 @@ func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequ
 				ElementType: types.StringType,
 			},
 			"credential_values": schema.MapAttribute{
-				Description: "Sensitive credential values (API keys, tokens, etc.).",
-				Required:    true,
+				Description: "Sensitive credential values (API keys, tokens, etc.). Exactly one of credential_values or credential_values_wo must be set.",
+				Optional:    true,
 				Sensitive:   true,
 				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ExactlyOneOf(path.MatchRoot("credential_values_wo")),
+				},
 			},
+			"credential_values_wo": schema.MapAttribute{
+				Description: writeOnlyDescription("Sensitive credential values (API keys, tokens, etc.).", "credential_values"),
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+			},
+			"credential_values_wo_version": writeOnlyVersionAttribute("credential_values_wo"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
@@ -100,10 +120,20 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// Write-only values are only available from the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credential_values_wo"), &data.CredentialValuesWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	credReq := r.buildCredentialRequest(ctx, &data)
+	data.CredentialValuesWO = types.MapNull(types.StringType)
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/credentials", credReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create credential: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create credential", err)
 		return
 	}
 
@@ -112,7 +142,7 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Read back for full state with retry (note: credential_values won't be returned for security).
 	// The retry handles eventual-consistency delays after creating a credential.
-	if err := r.readCredentialWithRetry(ctx, &data, 8); err != nil {
+	if err := r.readCredentialWithRetry(ctx, &data, readBackAttempts(ctx, 8)); err != nil {
 		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Credential created but failed to read back: %s", err))
 	}
 
@@ -127,12 +157,15 @@ func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readCredentialWithRetry(ctx, &data, 8); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read credential", err)
 		return
 	}
 
@@ -147,6 +180,9 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state CredentialResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -156,11 +192,18 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Preserve the ID
 	data.ID = state.ID
 
+	// Write-only values are only available from the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credential_values_wo"), &data.CredentialValuesWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	credReq := r.buildCredentialRequest(ctx, &data)
+	data.CredentialValuesWO = types.MapNull(types.StringType)
 
 	endpoint := fmt.Sprintf("/credentials/%s", data.CredentialName.ValueString())
 	if err := r.client.DoRequestWithResponse(ctx, "PATCH", endpoint, credReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update credential: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update credential", err)
 		return
 	}
 
@@ -180,10 +223,13 @@ func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	endpoint := fmt.Sprintf("/credentials/%s", data.CredentialName.ValueString())
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete credential: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete credential", err)
 			return
 		}
 	}
@@ -218,10 +264,14 @@ func (r *CredentialResource) buildCredentialRequest(ctx context.Context, data *C
 		}
 	}
 
-	// credential_values is required, so we always include it if present
-	if !data.CredentialValues.IsNull() && !data.CredentialValues.IsUnknown() {
+	// Exactly one of credential_values and credential_values_wo is set
+	values := data.CredentialValues
+	if !data.CredentialValuesWO.IsNull() {
+		values = data.CredentialValuesWO
+	}
+	if !values.IsNull() && !values.IsUnknown() {
 		var credValues map[string]string
-		data.CredentialValues.ElementsAs(ctx, &credValues, false)
+		values.ElementsAs(ctx, &credValues, false)
 		// Convert to map[string]interface{} for JSON
 		credValuesInterface := make(map[string]interface{})
 		for k, v := range credValues {
@@ -233,7 +283,10 @@ func (r *CredentialResource) buildCredentialRequest(ctx context.Context, data *C
 	return credReq
 }
 
-func (r *CredentialResource) readCredential(ctx context.Context, data *CredentialResourceModel) error {
+// readCredential reads the credential into data and also returns the raw API
+// response, which carries the credential values the resource does not read
+// back.
+func (r *CredentialResource) readCredential(ctx context.Context, data *CredentialResourceModel) (map[string]interface{}, error) {
 	credentialName := data.CredentialName.ValueString()
 	if credentialName == "" {
 		credentialName = data.ID.ValueString()
@@ -246,7 +299,7 @@ func (r *CredentialResource) readCredential(ctx context.Context, data *Credentia
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Update fields from response
@@ -271,34 +324,24 @@ func (r *CredentialResource) readCredential(ctx context.Context, data *Credentia
 	// Note: We don't update credential_values from the response for security reasons
 	// The API might not return sensitive values, and we want to preserve what's in state
 
-	return nil
+	return result, nil
 }
 
 // readCredentialWithRetry retries the read operation with exponential backoff.
 // This handles eventual-consistency delays after creating a credential.
 func (r *CredentialResource) readCredentialWithRetry(ctx context.Context, data *CredentialResourceModel, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		err = r.readCredential(ctx, data)
-		if err == nil {
-			return nil
-		}
-
-		if !IsNotFoundError(err) {
-			return err
-		}
-
-		if i < maxRetries-1 {
-			time.Sleep(delay)
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
+	_, err := r.fetchCredentialWithRetry(ctx, data, maxRetries)
 	return err
 }
+
+// fetchCredentialWithRetry is readCredentialWithRetry that also returns the
+// raw API response of the successful read.
+func (r *CredentialResource) fetchCredentialWithRetry(ctx context.Context, data *CredentialResourceModel, maxRetries int) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := r.client.retryWhile(ctx, maxRetries, IsNotFoundError, func() error {
+		var err error
+		result, err = r.readCredential(ctx, data)
+		return err
+	})
+	return result, err
+}
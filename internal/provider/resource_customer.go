@@ -0,0 +1,660 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CustomerResource{}
+var _ resource.ResourceWithImportState = &CustomerResource{}
+var _ resource.ResourceWithConfigValidators = &CustomerResource{}
+var _ resource.ResourceWithModifyPlan = &CustomerResource{}
+
+// customerBudgetFields are the budget settings a customer keeps in its own
+// budget. They cannot be combined with budget_id, which links a shared one.
+var customerBudgetFields = []string{"max_budget", "budget_duration", "tpm_limit", "rpm_limit", "model_max_budget"}
+
+func NewCustomerResource() resource.Resource {
+	return &CustomerResource{}
+}
+
+type CustomerResource struct {
+	client *Client
+}
+
+type CustomerResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	UserID             types.String   `tfsdk:"user_id"`
+	Alias              types.String   `tfsdk:"alias"`
+	Blocked            types.Bool     `tfsdk:"blocked"`
+	MaxBudget          types.Float64  `tfsdk:"max_budget"`
+	BudgetDuration     types.String   `tfsdk:"budget_duration"`
+	BudgetID           types.String   `tfsdk:"budget_id"`
+	ManagedBudgetID    types.String   `tfsdk:"managed_budget_id"`
+	TPMLimit           types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit           types.Int64    `tfsdk:"rpm_limit"`
+	AllowedModelRegion types.String   `tfsdk:"allowed_model_region"`
+	DefaultModel       types.String   `tfsdk:"default_model"`
+	ModelMaxBudget     types.Map      `tfsdk:"model_max_budget"`
+	ObjectPermission   types.Object   `tfsdk:"object_permission"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ModelBudgetModel is the budget of a customer for a single model.
+type ModelBudgetModel struct {
+	MaxBudget      types.Float64 `tfsdk:"max_budget"`
+	BudgetDuration types.String  `tfsdk:"budget_duration"`
+	TPMLimit       types.Int64   `tfsdk:"tpm_limit"`
+	RPMLimit       types.Int64   `tfsdk:"rpm_limit"`
+}
+
+var modelBudgetAttrTypes = map[string]attr.Type{
+	"max_budget":      types.Float64Type,
+	"budget_duration": types.StringType,
+	"tpm_limit":       types.Int64Type,
+	"rpm_limit":       types.Int64Type,
+}
+
+func (r *CustomerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer"
+}
+
+func (r *CustomerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a LiteLLM customer. Customers are the end users passed in the `user` field of requests, with their own budgets and model settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for this customer (same as user_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The customer ID, as sent in the `user` field of requests.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alias": schema.StringAttribute{
+				Description: "A descriptive name for the customer.",
+				Optional:    true,
+			},
+			"blocked": schema.BoolAttribute{
+				Description: "Whether requests for the customer are rejected. Default is false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"max_budget": schema.Float64Attribute{
+				Description: "Maximum budget for the customer. Requests fail once it is exceeded.",
+				Optional:    true,
+			},
+			"budget_duration": schema.StringAttribute{
+				Description: "Budget reset duration (e.g., '30s', '30m', '30h', '30d', '1mo').",
+				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
+			},
+			"budget_id": schema.StringAttribute{
+				Description: "ID of an existing budget to apply to the customer, e.g. from litellm_budget. Conflicts with the inline budget settings.",
+				Optional:    true,
+			},
+			"managed_budget_id": schema.StringAttribute{
+				Description: "ID of the budget the provider created to hold the inline budget settings of a customer that had none. It is deleted along with the customer, or when budget_id links another budget.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tpm_limit": schema.Int64Attribute{
+				Description: "Tokens per minute limit for the customer.",
+				Optional:    true,
+			},
+			"rpm_limit": schema.Int64Attribute{
+				Description: "Requests per minute limit for the customer.",
+				Optional:    true,
+			},
+			"allowed_model_region": schema.StringAttribute{
+				Description: "Region the customer's requests are restricted to: eu or us.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("eu", "us"),
+				},
+			},
+			"default_model": schema.StringAttribute{
+				Description: "Model used for the customer's requests when no model is given.",
+				Optional:    true,
+			},
+			"model_max_budget": schema.MapNestedAttribute{
+				Description: "Per-model budgets for the customer, keyed by model name.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"max_budget": schema.Float64Attribute{
+							Description: "Maximum budget for the model.",
+							Optional:    true,
+						},
+						"budget_duration": schema.StringAttribute{
+							Description: "Budget reset duration for the model.",
+							Optional:    true,
+							Validators: []validator.String{
+								litellmDuration(),
+							},
+						},
+						"tpm_limit": schema.Int64Attribute{
+							Description: "Tokens per minute limit for the model.",
+							Optional:    true,
+						},
+						"rpm_limit": schema.Int64Attribute{
+							Description: "Requests per minute limit for the model.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"object_permission": objectPermissionSchemaAttribute("MCP servers, tools, vector stores and agents the customer can use."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *CustomerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	// A linked budget replaces the customer's own, so its settings would be ignored.
+	validators := make([]resource.ConfigValidator, 0, len(customerBudgetFields))
+	for _, field := range customerBudgetFields {
+		validators = append(validators, resourcevalidator.Conflicting(path.MatchRoot("budget_id"), path.MatchRoot(field)))
+	}
+	return validators
+}
+
+func (r *CustomerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	customerReq := r.buildCustomerRequest(ctx, &data)
+	for k, v := range buildCustomerBudgetPayload(ctx, &data) {
+		customerReq[k] = v
+	}
+	customerReq["blocked"] = data.Blocked.ValueBool()
+
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/customer/new", customerReq, nil); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create customer", err)
+		return
+	}
+
+	data.ID = data.UserID
+	data.ManagedBudgetID = types.StringNull()
+
+	// Read back for full state
+	if err := r.readCustomer(ctx, &data); err != nil {
+		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Customer created but failed to read back: %s", err))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.readCustomer(ctx, &data); err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read customer", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	var state CustomerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = state.ID
+	data.ManagedBudgetID = state.ManagedBudgetID
+	if !data.BudgetID.IsNull() {
+		// The linked budget replaces the one the provider created.
+		data.ManagedBudgetID = types.StringNull()
+	}
+
+	customerReq := r.buildCustomerRequest(ctx, &data)
+	if !data.MaxBudget.IsNull() && !data.MaxBudget.IsUnknown() {
+		customerReq["max_budget"] = data.MaxBudget.ValueFloat64()
+	}
+	applyCustomerNullableClears(customerReq, &state, &data)
+
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/customer/update", customerReq, nil); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update customer", err)
+		return
+	}
+
+	// /customer/update only accepts max_budget, so the other budget settings
+	// are changed on the customer's own budget.
+	if customerBudgetChanged(&state, &data) && data.BudgetID.IsNull() {
+		budgetID, err := r.updateCustomerBudget(ctx, &data, state.BudgetID.ValueString())
+		if err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update customer budget", err)
+			return
+		}
+		if budgetID != "" {
+			data.ManagedBudgetID = types.StringValue(budgetID)
+		}
+	}
+	if old := state.ManagedBudgetID.ValueString(); old != "" && old != data.ManagedBudgetID.ValueString() {
+		if err := r.client.deleteBudget(ctx, old); err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to delete customer budget", err)
+			return
+		}
+	}
+
+	if !data.Blocked.Equal(state.Blocked) {
+		endpoint := "/customer/unblock"
+		if data.Blocked.ValueBool() {
+			endpoint = "/customer/block"
+		}
+		blockReq := map[string]interface{}{
+			"user_ids": []string{data.UserID.ValueString()},
+		}
+		if err := r.client.DoRequestWithResponse(ctx, "POST", endpoint, blockReq, nil); err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update customer blocked status", err)
+			return
+		}
+	}
+
+	// Read back for full state
+	if err := r.readCustomer(ctx, &data); err != nil {
+		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Customer updated but failed to read back: %s", err))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	deleteReq := map[string]interface{}{
+		"user_ids": []string{data.UserID.ValueString()},
+	}
+
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/customer/delete", deleteReq, nil); err != nil {
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete customer", err)
+			return
+		}
+	}
+
+	// LiteLLM keeps budgets of deleted customers, so the one the provider
+	// created is deleted here.
+	if budgetID := data.ManagedBudgetID.ValueString(); budgetID != "" {
+		if err := r.client.deleteBudget(ctx, budgetID); err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete customer budget", err)
+			return
+		}
+	}
+}
+
+// ModifyPlan marks managed_budget_id unknown when the update may create or
+// delete the customer's budget.
+func (r *CustomerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CustomerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if customerBudgetChanged(&state, &plan) || !plan.BudgetID.Equal(state.BudgetID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("managed_budget_id"), types.StringUnknown())...)
+	}
+}
+
+func (r *CustomerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+}
+
+// buildCustomerRequest returns the fields accepted by both /customer/new and
+// /customer/update.
+func (r *CustomerResource) buildCustomerRequest(ctx context.Context, data *CustomerResourceModel) map[string]interface{} {
+	customerReq := map[string]interface{}{
+		"user_id": data.UserID.ValueString(),
+	}
+
+	// String fields - check IsNull, IsUnknown, and empty string
+	if !data.Alias.IsNull() && !data.Alias.IsUnknown() && data.Alias.ValueString() != "" {
+		customerReq["alias"] = data.Alias.ValueString()
+	}
+	if !data.BudgetID.IsNull() && !data.BudgetID.IsUnknown() && data.BudgetID.ValueString() != "" {
+		customerReq["budget_id"] = data.BudgetID.ValueString()
+	}
+	if !data.AllowedModelRegion.IsNull() && !data.AllowedModelRegion.IsUnknown() && data.AllowedModelRegion.ValueString() != "" {
+		customerReq["allowed_model_region"] = data.AllowedModelRegion.ValueString()
+	}
+	if !data.DefaultModel.IsNull() && !data.DefaultModel.IsUnknown() && data.DefaultModel.ValueString() != "" {
+		customerReq["default_model"] = data.DefaultModel.ValueString()
+	}
+
+	if !data.ObjectPermission.IsNull() && !data.ObjectPermission.IsUnknown() {
+		customerReq["object_permission"] = buildObjectPermissionPayload(ctx, data.ObjectPermission)
+	}
+
+	return customerReq
+}
+
+// createCustomerBudget creates a budget with the customer's inline budget
+// settings, links it to the customer and returns its ID.
+func (r *CustomerResource) createCustomerBudget(ctx context.Context, data *CustomerResourceModel) (string, error) {
+	var result map[string]interface{}
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/budget/new", buildCustomerBudgetPayload(ctx, data), &result); err != nil {
+		return "", err
+	}
+	budgetID, _ := result["budget_id"].(string)
+	if budgetID == "" {
+		return "", fmt.Errorf("creating a budget for customer %q returned no budget_id", data.UserID.ValueString())
+	}
+
+	linkReq := map[string]interface{}{
+		"user_id":   data.UserID.ValueString(),
+		"budget_id": budgetID,
+	}
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/customer/update", linkReq, nil); err != nil {
+		// Do not leave a budget behind that nothing tracks.
+		_ = r.client.deleteBudget(ctx, budgetID)
+		return "", err
+	}
+	return budgetID, nil
+}
+
+// buildCustomerBudgetPayload returns the customer's inline budget settings.
+func buildCustomerBudgetPayload(ctx context.Context, data *CustomerResourceModel) map[string]interface{} {
+	budgetReq := map[string]interface{}{}
+
+	if !data.BudgetDuration.IsNull() && !data.BudgetDuration.IsUnknown() && data.BudgetDuration.ValueString() != "" {
+		budgetReq["budget_duration"] = data.BudgetDuration.ValueString()
+	}
+	if !data.MaxBudget.IsNull() && !data.MaxBudget.IsUnknown() {
+		budgetReq["max_budget"] = data.MaxBudget.ValueFloat64()
+	}
+	if !data.TPMLimit.IsNull() && !data.TPMLimit.IsUnknown() {
+		budgetReq["tpm_limit"] = data.TPMLimit.ValueInt64()
+	}
+	if !data.RPMLimit.IsNull() && !data.RPMLimit.IsUnknown() {
+		budgetReq["rpm_limit"] = data.RPMLimit.ValueInt64()
+	}
+
+	if !data.ModelMaxBudget.IsNull() && !data.ModelMaxBudget.IsUnknown() {
+		var budgets map[string]ModelBudgetModel
+		data.ModelMaxBudget.ElementsAs(ctx, &budgets, false)
+
+		modelBudgets := make(map[string]interface{}, len(budgets))
+		for model, budget := range budgets {
+			entry := map[string]interface{}{}
+			if !budget.MaxBudget.IsNull() {
+				entry["max_budget"] = budget.MaxBudget.ValueFloat64()
+			}
+			if !budget.BudgetDuration.IsNull() {
+				entry["budget_duration"] = budget.BudgetDuration.ValueString()
+			}
+			if !budget.TPMLimit.IsNull() {
+				entry["tpm_limit"] = budget.TPMLimit.ValueInt64()
+			}
+			if !budget.RPMLimit.IsNull() {
+				entry["rpm_limit"] = budget.RPMLimit.ValueInt64()
+			}
+			modelBudgets[model] = entry
+		}
+		budgetReq["model_max_budget"] = modelBudgets
+	}
+
+	return budgetReq
+}
+
+// applyCustomerNullableClears mutates customerReq to send explicit JSON null for
+// nullable fields that transition from set (non-null in state) to cleared (null in
+// plan). See applyTeamNullableClears in resource_team.go for the rationale.
+func applyCustomerNullableClears(customerReq map[string]interface{}, state, plan *CustomerResourceModel) {
+	if !state.Alias.IsNull() && plan.Alias.IsNull() {
+		customerReq["alias"] = nil
+	}
+	if !state.MaxBudget.IsNull() && plan.MaxBudget.IsNull() {
+		customerReq["max_budget"] = nil
+	}
+	if !state.BudgetID.IsNull() && plan.BudgetID.IsNull() {
+		customerReq["budget_id"] = nil
+	}
+	if !state.AllowedModelRegion.IsNull() && plan.AllowedModelRegion.IsNull() {
+		customerReq["allowed_model_region"] = nil
+	}
+	if !state.DefaultModel.IsNull() && plan.DefaultModel.IsNull() {
+		customerReq["default_model"] = nil
+	}
+	applyObjectPermissionClears(customerReq, state.ObjectPermission, plan.ObjectPermission)
+}
+
+// customerBudgetChanged reports whether a budget setting that /customer/update
+// does not accept has changed.
+func customerBudgetChanged(state, plan *CustomerResourceModel) bool {
+	return !state.BudgetDuration.Equal(plan.BudgetDuration) ||
+		!state.TPMLimit.Equal(plan.TPMLimit) ||
+		!state.RPMLimit.Equal(plan.RPMLimit) ||
+		!state.ModelMaxBudget.Equal(plan.ModelMaxBudget)
+}
+
+// updateCustomerBudget writes the inline budget settings to the customer's
+// own budget. A customer without a budget of its own, such as one created
+// without budget settings or previously linked to the shared budget
+// linkedBudgetID, gets a new budget instead, whose ID is returned.
+func (r *CustomerResource) updateCustomerBudget(ctx context.Context, data *CustomerResourceModel, linkedBudgetID string) (string, error) {
+	info, err := r.client.getCustomer(ctx, data.UserID.ValueString())
+	if err != nil {
+		return "", err
+	}
+	budgetTable, _ := info["litellm_budget_table"].(map[string]interface{})
+	budgetID, _ := budgetTable["budget_id"].(string)
+	if budgetID == "" || budgetID == linkedBudgetID {
+		return r.createCustomerBudget(ctx, data)
+	}
+
+	budgetReq := buildCustomerBudgetPayload(ctx, data)
+	budgetReq["budget_id"] = budgetID
+	for _, field := range []string{"budget_duration", "tpm_limit", "rpm_limit", "model_max_budget"} {
+		if _, ok := budgetReq[field]; !ok {
+			budgetReq[field] = nil
+		}
+	}
+	return "", r.client.DoRequestWithResponse(ctx, "POST", "/budget/update", budgetReq, nil)
+}
+
+// deleteBudget deletes a budget through /budget/delete. A budget that is
+// already gone is not an error.
+func (c *Client) deleteBudget(ctx context.Context, budgetID string) error {
+	deleteReq := map[string]interface{}{
+		"id": budgetID,
+	}
+	if err := c.DoRequestWithResponse(ctx, "POST", "/budget/delete", deleteReq, nil); err != nil && !isAlreadyDeletedError(err) {
+		return err
+	}
+	return nil
+}
+
+// getCustomer returns a customer from /customer/info.
+func (c *Client) getCustomer(ctx context.Context, userID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	endpoint := "/customer/info?end_user_id=" + url.QueryEscape(userID)
+	if err := c.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *CustomerResource) readCustomer(ctx context.Context, data *CustomerResourceModel) error {
+	userID := data.UserID.ValueString()
+	if userID == "" {
+		userID = data.ID.ValueString()
+	}
+
+	info, err := r.client.getCustomer(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if id, ok := info["user_id"].(string); ok && id != "" {
+		data.UserID = types.StringValue(id)
+		data.ID = types.StringValue(id)
+	}
+	if blocked, ok := info["blocked"].(bool); ok {
+		data.Blocked = types.BoolValue(blocked)
+	}
+
+	// Nullable string fields - preserve null when API returns empty and config didn't specify them
+	readString := func(v interface{}, current types.String) types.String {
+		if s, ok := v.(string); ok && s != "" {
+			return types.StringValue(s)
+		}
+		if !current.IsNull() {
+			return types.StringNull()
+		}
+		return current
+	}
+	data.Alias = readString(info["alias"], data.Alias)
+	data.AllowedModelRegion = readString(info["allowed_model_region"], data.AllowedModelRegion)
+	data.DefaultModel = readString(info["default_model"], data.DefaultModel)
+
+	// Budget fields live on the customer's budget. They are Optional-only, so
+	// avoid writing the settings of a linked or API-created budget into state
+	// when the user did not configure them.
+	budgetTable, _ := info["litellm_budget_table"].(map[string]interface{})
+	if budgetID, ok := budgetTable["budget_id"].(string); ok && !data.BudgetID.IsNull() {
+		data.BudgetID = types.StringValue(budgetID)
+	}
+	if maxBudget, ok := budgetTable["max_budget"].(float64); ok && !data.MaxBudget.IsNull() {
+		data.MaxBudget = types.Float64Value(maxBudget)
+	}
+	if budgetDuration, ok := budgetTable["budget_duration"].(string); ok && !data.BudgetDuration.IsNull() {
+		data.BudgetDuration = types.StringValue(budgetDuration)
+	}
+	if tpmLimit, ok := budgetTable["tpm_limit"].(float64); ok && !data.TPMLimit.IsNull() {
+		data.TPMLimit = types.Int64Value(int64(tpmLimit))
+	}
+	if rpmLimit, ok := budgetTable["rpm_limit"].(float64); ok && !data.RPMLimit.IsNull() {
+		data.RPMLimit = types.Int64Value(int64(rpmLimit))
+	}
+	if modelBudgets, ok := budgetTable["model_max_budget"].(map[string]interface{}); ok && !data.ModelMaxBudget.IsNull() {
+		data.ModelMaxBudget = parseModelBudgetsFromAPI(modelBudgets)
+	}
+
+	if perm, ok := info["object_permission"].(map[string]interface{}); ok {
+		data.ObjectPermission = parseObjectPermissionFromAPI(ctx, perm, data.ObjectPermission)
+	} else if data.ObjectPermission.IsUnknown() {
+		data.ObjectPermission = types.ObjectNull(objectPermissionAttrTypes)
+	}
+
+	return nil
+}
+
+// parseModelBudgetsFromAPI converts a budget's model_max_budget into a map of
+// model budgets. Unset settings of a model are null.
+func parseModelBudgetsFromAPI(modelBudgets map[string]interface{}) types.Map {
+	elems := make(map[string]attr.Value, len(modelBudgets))
+	for model, v := range modelBudgets {
+		entry, _ := v.(map[string]interface{})
+		attrs := map[string]attr.Value{
+			"max_budget":      types.Float64Null(),
+			"budget_duration": types.StringNull(),
+			"tpm_limit":       types.Int64Null(),
+			"rpm_limit":       types.Int64Null(),
+		}
+		if maxBudget, ok := entry["max_budget"].(float64); ok {
+			attrs["max_budget"] = types.Float64Value(maxBudget)
+		}
+		if budgetDuration, ok := entry["budget_duration"].(string); ok && budgetDuration != "" {
+			attrs["budget_duration"] = types.StringValue(budgetDuration)
+		}
+		if tpmLimit, ok := entry["tpm_limit"].(float64); ok {
+			attrs["tpm_limit"] = types.Int64Value(int64(tpmLimit))
+		}
+		if rpmLimit, ok := entry["rpm_limit"].(float64); ok {
+			attrs["rpm_limit"] = types.Int64Value(int64(rpmLimit))
+		}
+		elems[model] = types.ObjectValueMust(modelBudgetAttrTypes, attrs)
+	}
+	return types.MapValueMust(types.ObjectType{AttrTypes: modelBudgetAttrTypes}, elems)
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// customerServer serves /customer/info with the given customer and records
+// the bodies of the other endpoints it receives.
+func customerServer(t *testing.T, customer map[string]interface{}) (*httptest.Server, map[string]map[string]interface{}) {
+	t.Helper()
+
+	calls := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/customer/info" {
+			if r.URL.Query().Get("end_user_id") != "customer-1" {
+				t.Errorf("unexpected end_user_id %q", r.URL.Query().Get("end_user_id"))
+			}
+			_ = json.NewEncoder(w).Encode(customer)
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls[r.URL.Path] = body
+		if r.URL.Path == "/budget/new" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"budget_id": "budget-new"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{})
+	}))
+	return server, calls
+}
+
+func TestReadCustomerReadsConfiguredBudget(t *testing.T) {
+	t.Parallel()
+
+	server, _ := customerServer(t, map[string]interface{}{
+		"user_id":              "customer-1",
+		"alias":                "Acme",
+		"blocked":              true,
+		"allowed_model_region": "eu",
+		"default_model":        nil,
+		"litellm_budget_table": map[string]interface{}{
+			"budget_id":       "budget-auto",
+			"max_budget":      50.0,
+			"budget_duration": "1mo",
+			"tpm_limit":       1000.0,
+			"model_max_budget": map[string]interface{}{
+				"gpt-4o": map[string]interface{}{"max_budget": 10.0, "budget_duration": "1d"},
+			},
+		},
+	})
+	defer server.Close()
+
+	r := &CustomerResource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+	data := CustomerResourceModel{
+		UserID:             types.StringValue("customer-1"),
+		Alias:              types.StringValue("Old"),
+		MaxBudget:          types.Float64Value(25),
+		BudgetDuration:     types.StringNull(),
+		BudgetID:           types.StringNull(),
+		TPMLimit:           types.Int64Null(),
+		RPMLimit:           types.Int64Null(),
+		AllowedModelRegion: types.StringNull(),
+		DefaultModel:       types.StringValue("gpt-4o"),
+		ModelMaxBudget:     types.MapValueMust(types.ObjectType{AttrTypes: modelBudgetAttrTypes}, map[string]attr.Value{}),
+		ObjectPermission:   types.ObjectNull(objectPermissionAttrTypes),
+	}
+
+	if err := r.readCustomer(context.Background(), &data); err != nil {
+		t.Fatalf("readCustomer returned error: %v", err)
+	}
+
+	if data.ID.ValueString() != "customer-1" || data.Alias.ValueString() != "Acme" || !data.Blocked.ValueBool() {
+		t.Errorf("unexpected customer fields: %+v", data)
+	}
+	if data.AllowedModelRegion.ValueString() != "eu" {
+		t.Errorf("allowed_model_region = %v, want eu", data.AllowedModelRegion)
+	}
+	if !data.DefaultModel.IsNull() {
+		t.Errorf("default_model removed outside Terraform should read back as null, got %v", data.DefaultModel)
+	}
+	if data.MaxBudget.ValueFloat64() != 50 {
+		t.Errorf("max_budget = %v, want 50", data.MaxBudget)
+	}
+	if !data.BudgetID.IsNull() || !data.BudgetDuration.IsNull() || !data.TPMLimit.IsNull() {
+		t.Errorf("unconfigured budget settings should stay null, got %v %v %v", data.BudgetID, data.BudgetDuration, data.TPMLimit)
+	}
+
+	var budgets map[string]ModelBudgetModel
+	data.ModelMaxBudget.ElementsAs(context.Background(), &budgets, false)
+	if b, ok := budgets["gpt-4o"]; !ok || b.MaxBudget.ValueFloat64() != 10 || b.BudgetDuration.ValueString() != "1d" || !b.TPMLimit.IsNull() {
+		t.Errorf("model_max_budget = %v", data.ModelMaxBudget)
+	}
+}
+
+func TestUpdateCustomerBudgetUsesOwnBudget(t *testing.T) {
+	t.Parallel()
+
+	server, calls := customerServer(t, map[string]interface{}{
+		"user_id":              "customer-1",
+		"litellm_budget_table": map[string]interface{}{"budget_id": "budget-auto", "tpm_limit": 1000.0},
+	})
+	defer server.Close()
+
+	r := &CustomerResource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+	state := &CustomerResourceModel{TPMLimit: types.Int64Value(1000), RPMLimit: types.Int64Null()}
+	plan := &CustomerResourceModel{
+		UserID:         types.StringValue("customer-1"),
+		MaxBudget:      types.Float64Value(50),
+		BudgetDuration: types.StringValue("1mo"),
+		TPMLimit:       types.Int64Null(),
+		RPMLimit:       types.Int64Null(),
+	}
+	if !customerBudgetChanged(state, plan) {
+		t.Fatal("expected budget change to be detected")
+	}
+
+	budgetID, err := r.updateCustomerBudget(context.Background(), plan, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if budgetID != "" {
+		t.Errorf("updating the customer's own budget should not report a new budget, got %q", budgetID)
+	}
+	body, ok := calls["/budget/update"]
+	if !ok {
+		t.Fatal("expected a /budget/update call")
+	}
+	if body["budget_id"] != "budget-auto" || body["budget_duration"] != "1mo" || body["max_budget"] != 50.0 {
+		t.Errorf("unexpected budget update: %v", body)
+	}
+	if v, ok := body["tpm_limit"]; !ok || v != nil {
+		t.Errorf("removed tpm_limit should be cleared with null, got %v", body)
+	}
+}
+
+func TestUpdateCustomerBudgetCreatesMissingBudget(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		budgetTable    interface{}
+		linkedBudgetID string
+	}{
+		"no budget":     {budgetTable: nil},
+		"linked budget": {budgetTable: map[string]interface{}{"budget_id": "shared", "tpm_limit": 5.0}, linkedBudgetID: "shared"},
+	}
+
+	for name, tt := range tests {
+		server, calls := customerServer(t, map[string]interface{}{
+			"user_id":              "customer-1",
+			"litellm_budget_table": tt.budgetTable,
+		})
+
+		r := &CustomerResource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+		plan := &CustomerResourceModel{
+			UserID:         types.StringValue("customer-1"),
+			BudgetDuration: types.StringValue("1mo"),
+			TPMLimit:       types.Int64Value(1000),
+			RPMLimit:       types.Int64Null(),
+		}
+		budgetID, err := r.updateCustomerBudget(context.Background(), plan, tt.linkedBudgetID)
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if budgetID != "budget-new" {
+			t.Errorf("%s: expected the created budget to be returned for tracking, got %q", name, budgetID)
+		}
+
+		if _, ok := calls["/budget/update"]; ok {
+			t.Errorf("%s: the customer has no budget of its own to update", name)
+		}
+		if body := calls["/budget/new"]; body["budget_duration"] != "1mo" || body["tpm_limit"] != 1000.0 {
+			t.Errorf("%s: unexpected new budget: %v", name, body)
+		}
+		if body := calls["/customer/update"]; body["user_id"] != "customer-1" || body["budget_id"] != "budget-new" {
+			t.Errorf("%s: expected the new budget to be linked, got %v", name, body)
+		}
+	}
+}
+
+func TestCustomerDeleteRemovesManagedBudget(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		managedBudgetID tftypes.Value
+		wantDelete      bool
+	}{
+		"managed budget":    {managedBudgetID: tftypes.NewValue(tftypes.String, "budget-new"), wantDelete: true},
+		"no managed budget": {managedBudgetID: tftypes.NewValue(tftypes.String, nil)},
+	}
+
+	for name, tt := range tests {
+		server, calls := customerServer(t, map[string]interface{}{"user_id": "customer-1"})
+
+		r := &CustomerResource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+		raw, schemaResp := resourceObjectValue(t, r, map[string]tftypes.Value{
+			"user_id":           tftypes.NewValue(tftypes.String, "customer-1"),
+			"managed_budget_id": tt.managedBudgetID,
+		})
+		var resp resource.DeleteResponse
+		r.Delete(context.Background(), resource.DeleteRequest{State: tfsdk.State{Raw: raw, Schema: schemaResp.Schema}}, &resp)
+		server.Close()
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+		if _, ok := calls["/customer/delete"]; !ok {
+			t.Errorf("%s: expected the customer to be deleted", name)
+		}
+		body, deleted := calls["/budget/delete"]
+		if deleted != tt.wantDelete {
+			t.Errorf("%s: expected the budget to be deleted %t", name, tt.wantDelete)
+		}
+		if tt.wantDelete && body["id"] != "budget-new" {
+			t.Errorf("%s: unexpected budget delete: %v", name, body)
+		}
+	}
+}
+
+func TestCustomerResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"own budget": {
+			values: map[string]tftypes.Value{
+				"max_budget": tftypes.NewValue(tftypes.Number, 10),
+				"tpm_limit":  tftypes.NewValue(tftypes.Number, 1000),
+			},
+		},
+		"linked budget": {
+			values: map[string]tftypes.Value{
+				"budget_id": tftypes.NewValue(tftypes.String, "budget-1"),
+			},
+		},
+		"linked budget with inline settings": {
+			values: map[string]tftypes.Value{
+				"budget_id":  tftypes.NewValue(tftypes.String, "budget-1"),
+				"max_budget": tftypes.NewValue(tftypes.Number, 10),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		tt.values["user_id"] = tftypes.NewValue(tftypes.String, "customer-1")
+		diags := validateResourceConfig(t, &CustomerResource{}, tt.values)
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantErr, diags)
+		}
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,10 +28,11 @@ type FallbackResource struct {
 }
 
 type FallbackResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	Model          types.String `tfsdk:"model"`
-	FallbackModels types.List   `tfsdk:"fallback_models"`
-	FallbackType   types.String `tfsdk:"fallback_type"`
+	ID             types.String   `tfsdk:"id"`
+	Model          types.String   `tfsdk:"model"`
+	FallbackModels types.List     `tfsdk:"fallback_models"`
+	FallbackType   types.String   `tfsdk:"fallback_type"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *FallbackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,6 +72,9 @@ func (r *FallbackResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -96,15 +100,18 @@ func (r *FallbackResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	fallbackReq := r.buildFallbackRequest(ctx, &data)
 	if err := r.writeFallbackWithRetry(ctx, fallbackReq, 5); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create fallback: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create fallback", err)
 		return
 	}
 
 	data.ID = types.StringValue(data.Model.ValueString() + ":" + data.FallbackType.ValueString())
 
-	if err := r.readFallbackWithRetry(ctx, &data, 5); err != nil {
+	if err := r.readFallbackWithRetry(ctx, &data, readBackAttempts(ctx, 5)); err != nil {
 		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Fallback created but failed to read back: %s", err))
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -117,12 +124,15 @@ func (r *FallbackResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readFallbackWithRetry(ctx, &data, 5); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read fallback: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read fallback", err)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -135,9 +145,12 @@ func (r *FallbackResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	fallbackReq := r.buildFallbackRequest(ctx, &data)
 	if err := r.writeFallbackWithRetry(ctx, fallbackReq, 5); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update fallback: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update fallback", err)
 		return
 	}
 
@@ -154,12 +167,15 @@ func (r *FallbackResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	endpoint := fmt.Sprintf("/fallback/%s?fallback_type=%s",
 		url.PathEscape(data.Model.ValueString()),
 		url.QueryEscape(data.FallbackType.ValueString()))
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete fallback: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete fallback", err)
 			return
 		}
 	}
@@ -168,7 +184,7 @@ func (r *FallbackResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *FallbackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// ID format: model:fallback_type
 	importID := req.ID
-	data := FallbackResourceModel{}
+	data := FallbackResourceModel{Timeouts: nullTimeouts(ctx)}
 	for i := 0; i < len(importID); i++ {
 		if importID[i] == ':' {
 			data.Model = types.StringValue(importID[:i])
@@ -202,30 +218,9 @@ func (r *FallbackResource) buildFallbackRequest(ctx context.Context, data *Fallb
 }
 
 func (r *FallbackResource) writeFallbackWithRetry(ctx context.Context, fallbackReq map[string]interface{}, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		err = r.client.DoRequestWithResponse(ctx, "POST", "/fallback", fallbackReq, nil)
-		if err == nil {
-			return nil
-		}
-
-		if !shouldRetryFallbackWriteError(err) {
-			return err
-		}
-
-		if i < maxRetries-1 {
-			time.Sleep(delay)
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
-	return err
+	return r.client.retryWhile(ctx, maxRetries, shouldRetryFallbackWriteError, func() error {
+		return r.client.DoRequestWithResponse(ctx, "POST", "/fallback", fallbackReq, nil)
+	})
 }
 
 func shouldRetryFallbackWriteError(err error) bool {
@@ -238,30 +233,9 @@ func shouldRetryFallbackWriteError(err error) bool {
 }
 
 func (r *FallbackResource) readFallbackWithRetry(ctx context.Context, data *FallbackResourceModel, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		err = r.readFallback(ctx, data)
-		if err == nil {
-			return nil
-		}
-
-		if !IsNotFoundError(err) {
-			return err
-		}
-
-		if i < maxRetries-1 {
-			time.Sleep(delay)
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
-	return err
+	return r.client.retryWhile(ctx, maxRetries, IsNotFoundError, func() error {
+		return r.readFallback(ctx, data)
+	})
 }
 
 func (r *FallbackResource) readFallback(ctx context.Context, data *FallbackResourceModel) error {
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -25,15 +27,16 @@ type GuardrailResource struct {
 }
 
 type GuardrailResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	GuardrailID   types.String `tfsdk:"guardrail_id"`
-	GuardrailName types.String `tfsdk:"guardrail_name"`
-	Guardrail     types.String `tfsdk:"guardrail"`
-	Mode          types.String `tfsdk:"mode"`
-	DefaultOn     types.Bool   `tfsdk:"default_on"`
-	LitellmParams types.String `tfsdk:"litellm_params"`
-	GuardrailInfo types.String `tfsdk:"guardrail_info"`
-	CreatedAt     types.String `tfsdk:"created_at"`
+	ID            types.String   `tfsdk:"id"`
+	GuardrailID   types.String   `tfsdk:"guardrail_id"`
+	GuardrailName types.String   `tfsdk:"guardrail_name"`
+	Guardrail     types.String   `tfsdk:"guardrail"`
+	Mode          types.String   `tfsdk:"mode"`
+	DefaultOn     types.Bool     `tfsdk:"default_on"`
+	LitellmParams types.String   `tfsdk:"litellm_params"`
+	GuardrailInfo types.String   `tfsdk:"guardrail_info"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *GuardrailResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,6 +74,9 @@ func (r *GuardrailResource) Schema(ctx context.Context, req resource.SchemaReque
 			"mode": schema.StringAttribute{
 				Description: "When to apply the guardrail. Can be a single value or JSON array (e.g., 'pre_call', 'post_call', 'during_call', '[\"pre_call\", \"post_call\"]').",
 				Required:    true,
+				Validators: []validator.String{
+					guardrailMode(),
+				},
 			},
 			"default_on": schema.BoolAttribute{
 				Description: "Whether the guardrail is enabled by default for all requests.",
@@ -92,6 +98,9 @@ func (r *GuardrailResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -120,11 +129,14 @@ func (r *GuardrailResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	guardrailReq := r.buildGuardrailRequest(ctx, &data)
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/guardrails", guardrailReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create guardrail: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create guardrail", err)
 		return
 	}
 
@@ -155,12 +167,15 @@ func (r *GuardrailResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readGuardrail(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read guardrail: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read guardrail", err)
 		return
 	}
 
@@ -175,6 +190,9 @@ func (r *GuardrailResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state GuardrailResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -189,7 +207,7 @@ func (r *GuardrailResource) Update(ctx context.Context, req resource.UpdateReque
 
 	endpoint := fmt.Sprintf("/guardrails/%s", data.GuardrailID.ValueString())
 	if err := r.client.DoRequestWithResponse(ctx, "PUT", endpoint, guardrailReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update guardrail: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update guardrail", err)
 		return
 	}
 
@@ -209,10 +227,13 @@ func (r *GuardrailResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	endpoint := fmt.Sprintf("/guardrails/%s", data.GuardrailID.ValueString())
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete guardrail: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete guardrail", err)
 			return
 		}
 	}
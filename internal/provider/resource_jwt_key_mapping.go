@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &JWTKeyMappingResource{}
+var _ resource.ResourceWithImportState = &JWTKeyMappingResource{}
+
+func NewJWTKeyMappingResource() resource.Resource {
+	return &JWTKeyMappingResource{}
+}
+
+// JWTKeyMappingResource maps a JWT claim value to a LiteLLM virtual key, so
+// that requests authenticated with a matching JWT use that key.
+type JWTKeyMappingResource struct {
+	client *Client
+}
+
+type JWTKeyMappingResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	JWTClaimName  types.String   `tfsdk:"jwt_claim_name"`
+	JWTClaimValue types.String   `tfsdk:"jwt_claim_value"`
+	Key           types.String   `tfsdk:"key"`
+	Description   types.String   `tfsdk:"description"`
+	IsActive      types.Bool     `tfsdk:"is_active"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *JWTKeyMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_key_mapping"
+}
+
+func (r *JWTKeyMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Maps a JWT claim value to a LiteLLM virtual key. Requests authenticated with a JWT carrying the claim value use the mapped key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier for this mapping.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jwt_claim_name": schema.StringAttribute{
+				Description: "Name of the JWT claim to match (e.g. 'sub' or 'client_id').",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"jwt_claim_value": schema.StringAttribute{
+				Description: "Value of the JWT claim that selects the mapped key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The API key value to map to, such as the key of a litellm_key resource.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the mapping.",
+				Optional:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the mapping is used to authenticate requests. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *JWTKeyMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *JWTKeyMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JWTKeyMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	createReq := map[string]interface{}{
+		"jwt_claim_name":  data.JWTClaimName.ValueString(),
+		"jwt_claim_value": data.JWTClaimValue.ValueString(),
+		"key":             data.Key.ValueString(),
+	}
+	if !data.Description.IsNull() {
+		createReq["description"] = data.Description.ValueString()
+	}
+
+	var result map[string]interface{}
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/jwt/key/mapping/new", createReq, &result); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create JWT key mapping", err)
+		return
+	}
+
+	id, _ := result["id"].(string)
+	if id == "" {
+		resp.Diagnostics.AddError("Client Error", "The JWT key mapping response did not include an id")
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	// New mappings are active; apply is_active = false with a follow-up update
+	if !data.IsActive.ValueBool() {
+		if err := r.updateMapping(ctx, &data); err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to deactivate JWT key mapping", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JWTKeyMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JWTKeyMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.readMapping(ctx, &data); err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read JWT key mapping", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JWTKeyMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JWTKeyMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	var state JWTKeyMappingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = state.ID
+
+	if err := r.updateMapping(ctx, &data); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update JWT key mapping", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JWTKeyMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data JWTKeyMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	deleteReq := map[string]interface{}{
+		"id": data.ID.ValueString(),
+	}
+
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/jwt/key/mapping/delete", deleteReq, nil); err != nil {
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete JWT key mapping", err)
+			return
+		}
+	}
+}
+
+func (r *JWTKeyMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// updateMapping sends the mutable fields of data to /jwt/key/mapping/update.
+func (r *JWTKeyMappingResource) updateMapping(ctx context.Context, data *JWTKeyMappingResourceModel) error {
+	updateReq := map[string]interface{}{
+		"id":        data.ID.ValueString(),
+		"key":       data.Key.ValueString(),
+		"is_active": data.IsActive.ValueBool(),
+	}
+	if !data.Description.IsNull() {
+		updateReq["description"] = data.Description.ValueString()
+	} else {
+		updateReq["description"] = nil
+	}
+
+	return r.client.DoRequestWithResponse(ctx, "POST", "/jwt/key/mapping/update", updateReq, nil)
+}
+
+// readMapping refreshes data from /jwt/key/mapping/info. LiteLLM does not
+// return the mapped key, so key keeps its configured value.
+func (r *JWTKeyMappingResource) readMapping(ctx context.Context, data *JWTKeyMappingResourceModel) error {
+	endpoint := fmt.Sprintf("/jwt/key/mapping/info?id=%s", url.QueryEscape(data.ID.ValueString()))
+
+	var result map[string]interface{}
+	if err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &result); err != nil {
+		return err
+	}
+
+	item := jwtKeyMappingListItemFromAPI(result)
+	data.JWTClaimName = item.JWTClaimName
+	data.JWTClaimValue = item.JWTClaimValue
+	data.Description = item.Description
+	if !item.IsActive.IsNull() {
+		data.IsActive = item.IsActive
+	}
+
+	return nil
+}
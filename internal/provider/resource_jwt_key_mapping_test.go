@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReadJWTKeyMappingKeepsKey(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/jwt/key/mapping/info" || r.URL.Query().Get("id") != "mapping-1" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":              "mapping-1",
+			"jwt_claim_name":  "client_id",
+			"jwt_claim_value": "billing-app",
+			"description":     nil,
+			"is_active":       false,
+		})
+	}))
+	defer server.Close()
+
+	r := &JWTKeyMappingResource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+	data := JWTKeyMappingResourceModel{
+		ID:            types.StringValue("mapping-1"),
+		JWTClaimName:  types.StringValue("sub"),
+		JWTClaimValue: types.StringValue("billing-app"),
+		Key:           types.StringValue("sk-billing"),
+		Description:   types.StringValue("removed outside Terraform"),
+		IsActive:      types.BoolValue(true),
+	}
+
+	if err := r.readMapping(context.Background(), &data); err != nil {
+		t.Fatalf("readMapping returned error: %v", err)
+	}
+
+	if data.JWTClaimName.ValueString() != "client_id" || data.IsActive.ValueBool() {
+		t.Errorf("unexpected mapping fields: %+v", data)
+	}
+	if !data.Description.IsNull() {
+		t.Errorf("description = %v, want null", data.Description)
+	}
+	if data.Key.ValueString() != "sk-billing" {
+		t.Errorf("key should keep its configured value, got %v", data.Key)
+	}
+}
+
+func TestJWTKeyMappingsListFollowsPages(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("size") != "100" {
+			t.Errorf("unexpected size %q", r.URL.Query().Get("size"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		id := "mapping-" + r.URL.Query().Get("page")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data":        []interface{}{map[string]interface{}{"id": id, "jwt_claim_name": "sub"}},
+			"total_pages": 2,
+		})
+	}))
+	defer server.Close()
+
+	d := &JWTKeyMappingsListDataSource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+
+	var ids []string
+	for page := 1; page <= 2; page++ {
+		mappings, totalPages, err := d.listMappings(context.Background(), page)
+		if err != nil {
+			t.Fatalf("listMappings returned error: %v", err)
+		}
+		if totalPages != 2 {
+			t.Errorf("totalPages = %d, want 2", totalPages)
+		}
+		for _, m := range mappings {
+			ids = append(ids, jwtKeyMappingListItemFromAPI(m).ID.ValueString())
+		}
+	}
+	if len(ids) != 2 || ids[0] != "mapping-1" || ids[1] != "mapping-2" {
+		t.Errorf("unexpected mapping ids: %v", ids)
+	}
+}
+
+func TestUpdateJWTKeyMappingSendsKey(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/jwt/key/mapping/update" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": "mapping-1"})
+	}))
+	defer server.Close()
+
+	r := &JWTKeyMappingResource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+	data := JWTKeyMappingResourceModel{
+		ID:          types.StringValue("mapping-1"),
+		Key:         types.StringValue("sk-billing"),
+		Description: types.StringNull(),
+		IsActive:    types.BoolValue(true),
+	}
+	if err := r.updateMapping(context.Background(), &data); err != nil {
+		t.Fatalf("updateMapping returned error: %v", err)
+	}
+
+	if body["id"] != "mapping-1" || body["key"] != "sk-billing" || body["is_active"] != true {
+		t.Errorf("unexpected update request: %v", body)
+	}
+	if v, ok := body["description"]; !ok || v != nil {
+		t.Errorf("an unset description should be cleared with null, got %v", body)
+	}
+}
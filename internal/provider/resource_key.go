@@ -6,13 +6,22 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +29,10 @@ import (
 
 var _ resource.Resource = &KeyResource{}
 var _ resource.ResourceWithImportState = &KeyResource{}
+var _ resource.ResourceWithIdentity = &KeyResource{}
+var _ resource.ResourceWithModifyPlan = &KeyResource{}
+var _ resource.ResourceWithConfigValidators = &KeyResource{}
+var _ resource.ResourceWithValidateConfig = &KeyResource{}
 var _ resource.ResourceWithUpgradeState = &KeyResource{}
 
 // hashKeyForID produces a non-sensitive identifier from a raw API key.
@@ -38,44 +51,81 @@ type KeyResource struct {
 }
 
 type KeyResourceModel struct {
-	ID                       types.String  `tfsdk:"id"`
-	Key                      types.String  `tfsdk:"key"`
-	Models                   types.List    `tfsdk:"models"`
-	AllowedRoutes            types.List    `tfsdk:"allowed_routes"`
-	AllowedPassthroughRoutes types.List    `tfsdk:"allowed_passthrough_routes"`
-	MaxBudget                types.Float64 `tfsdk:"max_budget"`
-	UserID                   types.String  `tfsdk:"user_id"`
-	TeamID                   types.String  `tfsdk:"team_id"`
-	OrganizationID           types.String  `tfsdk:"organization_id"`
-	ProjectID                types.String  `tfsdk:"project_id"`
-	BudgetID                 types.String  `tfsdk:"budget_id"`
-	ServiceAccountID         types.String  `tfsdk:"service_account_id"`
-	MaxParallelRequests      types.Int64   `tfsdk:"max_parallel_requests"`
-	Metadata                 types.Map     `tfsdk:"metadata"`
-	TPMLimit                 types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit                 types.Int64   `tfsdk:"rpm_limit"`
-	TPMLimitType             types.String  `tfsdk:"tpm_limit_type"`
-	RPMLimitType             types.String  `tfsdk:"rpm_limit_type"`
-	BudgetDuration           types.String  `tfsdk:"budget_duration"`
-	AllowedCacheControls     types.List    `tfsdk:"allowed_cache_controls"`
-	SoftBudget               types.Float64 `tfsdk:"soft_budget"`
-	KeyAlias                 types.String  `tfsdk:"key_alias"`
-	Duration                 types.String  `tfsdk:"duration"`
-	Aliases                  types.Map     `tfsdk:"aliases"`
-	Config                   types.Map     `tfsdk:"config"`
-	Permissions              types.Map     `tfsdk:"permissions"`
-	ModelMaxBudget           types.Map     `tfsdk:"model_max_budget"`
-	ModelRPMLimit            types.Map     `tfsdk:"model_rpm_limit"`
-	ModelTPMLimit            types.Map     `tfsdk:"model_tpm_limit"`
-	Guardrails               types.List    `tfsdk:"guardrails"`
-	Prompts                  types.List    `tfsdk:"prompts"`
-	EnforcedParams           types.List    `tfsdk:"enforced_params"`
-	Tags                     types.List    `tfsdk:"tags"`
-	Blocked                  types.Bool    `tfsdk:"blocked"`
+	ID                        types.String   `tfsdk:"id"`
+	Key                       types.String   `tfsdk:"key"`
+	Token                     types.String   `tfsdk:"token"`
+	Models                    types.List     `tfsdk:"models"`
+	AllowedRoutes             types.List     `tfsdk:"allowed_routes"`
+	AllowedPassthroughRoutes  types.List     `tfsdk:"allowed_passthrough_routes"`
+	MaxBudget                 types.Float64  `tfsdk:"max_budget"`
+	UserID                    types.String   `tfsdk:"user_id"`
+	TeamID                    types.String   `tfsdk:"team_id"`
+	OrganizationID            types.String   `tfsdk:"organization_id"`
+	ProjectID                 types.String   `tfsdk:"project_id"`
+	BudgetID                  types.String   `tfsdk:"budget_id"`
+	ServiceAccountID          types.String   `tfsdk:"service_account_id"`
+	MaxParallelRequests       types.Int64    `tfsdk:"max_parallel_requests"`
+	Metadata                  types.Map      `tfsdk:"metadata"`
+	TPMLimit                  types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit                  types.Int64    `tfsdk:"rpm_limit"`
+	TPMLimitType              types.String   `tfsdk:"tpm_limit_type"`
+	RPMLimitType              types.String   `tfsdk:"rpm_limit_type"`
+	BudgetDuration            types.String   `tfsdk:"budget_duration"`
+	AllowedCacheControls      types.List     `tfsdk:"allowed_cache_controls"`
+	SoftBudget                types.Float64  `tfsdk:"soft_budget"`
+	KeyAlias                  types.String   `tfsdk:"key_alias"`
+	Duration                  types.String   `tfsdk:"duration"`
+	Aliases                   types.Map      `tfsdk:"aliases"`
+	Config                    types.Map      `tfsdk:"config"`
+	Permissions               types.Map      `tfsdk:"permissions"`
+	ModelMaxBudget            types.Map      `tfsdk:"model_max_budget"`
+	ModelRPMLimit             types.Map      `tfsdk:"model_rpm_limit"`
+	ModelTPMLimit             types.Map      `tfsdk:"model_tpm_limit"`
+	Guardrails                types.List     `tfsdk:"guardrails"`
+	Prompts                   types.List     `tfsdk:"prompts"`
+	EnforcedParams            types.List     `tfsdk:"enforced_params"`
+	Tags                      types.List     `tfsdk:"tags"`
+	MetadataAll               types.Map      `tfsdk:"metadata_all"`
+	TagsAll                   types.List     `tfsdk:"tags_all"`
+	Blocked                   types.Bool     `tfsdk:"blocked"`
+	AutoRotate                types.Bool     `tfsdk:"auto_rotate"`
+	RotationInterval          types.String   `tfsdk:"rotation_interval"`
+	RotationTrigger           types.String   `tfsdk:"rotation_trigger"`
+	RotateAfter               types.String   `tfsdk:"rotate_after"`
+	RotatedAt                 types.String   `tfsdk:"rotated_at"`
+	ObjectPermission          types.Object   `tfsdk:"object_permission"`
+	AccessGroupIDs            types.List     `tfsdk:"access_group_ids"`
+	Policies                  types.List     `tfsdk:"policies"`
+	AllowedVectorStoreIndexes types.List     `tfsdk:"allowed_vector_store_indexes"`
+	DisableGlobalGuardrails   types.Bool     `tfsdk:"disable_global_guardrails"`
+	RouterSettings            types.Object   `tfsdk:"router_settings"`
+	BudgetLimits              types.List     `tfsdk:"budget_limits"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+type VectorStoreIndexModel struct {
+	IndexName        types.String `tfsdk:"index_name"`
+	IndexPermissions types.List   `tfsdk:"index_permissions"`
+}
+
+var vectorStoreIndexAttrTypes = map[string]attr.Type{
+	"index_name":        types.StringType,
+	"index_permissions": types.ListType{ElemType: types.StringType},
+}
+
+type KeyIdentityModel struct {
+	Token types.String `tfsdk:"token"`
+}
+
+func keyIdentity(data *KeyResourceModel) KeyIdentityModel {
+	return KeyIdentityModel{Token: types.StringValue(keyToken(data.Key.ValueString()))}
 }
 
 func (r *KeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_key"
+	// Regenerating the key, whether through rotation_trigger, rotate_after or
+	// LiteLLM's auto-rotation, changes the hashed token the identity holds.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -99,6 +149,13 @@ func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"token": schema.StringAttribute{
+				Description: "Hashed token LiteLLM stores for the key, as listed by /key/list and used as the key's resource identity. Unlike key it is not sensitive.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"models": schema.ListAttribute{
 				Description: "List of models this key can access.",
 				Optional:    true,
@@ -135,7 +192,7 @@ func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Optional:    true,
 			},
 			"project_id": schema.StringAttribute{
-				Description: "Project ID associated with this key. When set, models and budget are validated against the project's limits.",
+				Description: "Project ID associated with this key. When set, models and budget are checked against the project's limits at plan time.",
 				Optional:    true,
 			},
 			"budget_id": schema.StringAttribute{
@@ -168,17 +225,27 @@ func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Computed:    true,
 			},
 			"tpm_limit_type": schema.StringAttribute{
-				Description: "Type of TPM limit: 'key' (default) or 'team'. If 'team', TPM is shared across all keys for the team.",
+				Description: "How the TPM limit is enforced: guaranteed_throughput, best_effort_throughput or dynamic.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(limitTypeValues...),
+				},
 			},
 			"rpm_limit_type": schema.StringAttribute{
-				Description: "Type of RPM limit: 'key' (default) or 'team'. If 'team', RPM is shared across all keys for the team.",
+				Description: "How the RPM limit is enforced: guaranteed_throughput, best_effort_throughput or dynamic.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(limitTypeValues...),
+				},
 			},
 			"budget_duration": schema.StringAttribute{
 				Description: "Budget reset duration (e.g., '30d', '1h').",
 				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
 			},
+			"budget_limits": budgetLimitsSchemaAttribute("key"),
 			"allowed_cache_controls": schema.ListAttribute{
 				Description: "Allowed cache control values.",
 				Optional:    true,
@@ -199,8 +266,11 @@ func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				},
 			},
 			"duration": schema.StringAttribute{
-				Description: "Key validity duration.",
+				Description: "Key validity duration (e.g., '30d', '1h'), or '-1' for a key that never expires.",
 				Optional:    true,
+				Validators: []validator.String{
+					litellmDurationOrNever(),
+				},
 			},
 			"aliases": schema.MapAttribute{
 				Description: "Model alias mappings.",
@@ -262,11 +332,95 @@ func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"metadata_all": metadataAllSchemaAttribute(),
+			"tags_all":     tagsAllSchemaAttribute(),
 			"blocked": schema.BoolAttribute{
 				Description: "Whether the key is blocked.",
 				Optional:    true,
 				Computed:    true,
 			},
+			"auto_rotate": schema.BoolAttribute{
+				Description: "Whether LiteLLM automatically regenerates the key every rotation_interval. The provider follows a rotated key through its key_alias. Conflicts with key.",
+				Optional:    true,
+			},
+			"rotation_interval": schema.StringAttribute{
+				Description: "How often LiteLLM regenerates the key when auto_rotate is true (e.g., '30d').",
+				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Description: "Arbitrary value that regenerates the key in place through /key/{key}/regenerate whenever it changes. Alias, budgets and spend are kept.",
+				Optional:    true,
+			},
+			"rotate_after": schema.StringAttribute{
+				Description: "Regenerate the key in place on the first apply after it is older than this duration (e.g., '90d'), measured from rotated_at. The age is checked when planning, so a saved plan made before the key was due fails to apply once it is due; run terraform plan again.",
+				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				Description: "RFC 3339 time at which Terraform created or last regenerated the key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"object_permission": objectPermissionSchemaAttribute("MCP servers, MCP tools, vector stores and agents this key can use."),
+			"access_group_ids": schema.ListAttribute{
+				Description: "Access group IDs that grant this key access to their models, MCP servers and agents.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"policies": schema.ListAttribute{
+				Description: "Names of the guardrail policies applied to requests made with this key.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"allowed_vector_store_indexes": schema.ListNestedAttribute{
+				Description: "Vector store indexes this key can use and the operations allowed on each.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index_name": schema.StringAttribute{
+							Description: "Name of the vector store index.",
+							Required:    true,
+						},
+						"index_permissions": schema.ListAttribute{
+							Description: "Operations allowed on the index: read, write or both.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf("read", "write")),
+							},
+						},
+					},
+				},
+			},
+			"disable_global_guardrails": schema.BoolAttribute{
+				Description: "Whether guardrails that run on every request by default are skipped for this key.",
+				Optional:    true,
+			},
+			"router_settings": routerSettingsSchemaAttribute("Router settings for the key, including fallback configurations. " +
+				"These override team and global fallback settings for requests made with this key. " +
+				"Resolution order: Key > Team > Global."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *KeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"token": identityschema.StringAttribute{
+				Description:       "Hashed token LiteLLM stores for the key (SHA256 of the key value), as returned by /key/list.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -288,6 +442,138 @@ func (r *KeyResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+func (r *KeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var projectID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	if !projectID.IsNull() {
+		r.client.requireCapability(ctx, &resp.Diagnostics, capabilityProjects, "Setting project_id", path.Root("project_id"))
+	}
+
+	r.client.planDefaults(ctx, req, resp, "metadata", true)
+	r.client.planParentLimits(ctx, req, resp, "team", "team_id")
+	r.client.planParentLimits(ctx, req, resp, "project", "project_id")
+	r.client.planParentLimits(ctx, req, resp, "organization", "organization_id")
+	r.planRotation(ctx, req, resp)
+}
+
+func (r *KeyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	// Rotation generates a new key value, which a configured key would undo.
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(path.MatchRoot("key"), path.MatchRoot("rotation_trigger")),
+		resourcevalidator.Conflicting(path.MatchRoot("key"), path.MatchRoot("rotate_after")),
+		resourcevalidator.Conflicting(path.MatchRoot("key"), path.MatchRoot("auto_rotate")),
+	}
+}
+
+func (r *KeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var autoRotate types.Bool
+	var rotationInterval types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auto_rotate"), &autoRotate)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rotation_interval"), &rotationInterval)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if autoRotate.ValueBool() && rotationInterval.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotation_interval"),
+			"Missing Attribute Configuration",
+			"rotation_interval must be set when auto_rotate is true.",
+		)
+	}
+}
+
+// planRotation plans an in-place regeneration of the key when
+// rotation_trigger changes or the key is older than rotate_after, leaving
+// key, id and rotated_at unknown; Update recognizes the regeneration with
+// keyRotationPlanned. A configured key that changes is also applied in place,
+// so only its id is unknown.
+//
+// rotate_after is compared with the current time, so a saved plan created
+// just before the key became due cannot be applied once it is due: Terraform
+// reports an inconsistent final plan and a new plan is needed.
+func (r *KeyResource) planRotation(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state KeyResourceModel
+	var configKey types.String
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &configKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if keyValueChanged(configKey, state.Key) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), types.StringUnknown())...)
+		return
+	}
+
+	rotate := !plan.RotationTrigger.IsNull() && !plan.RotationTrigger.Equal(state.RotationTrigger)
+	if !plan.RotateAfter.IsNull() && !plan.RotateAfter.IsUnknown() {
+		if state.RotatedAt.IsNull() {
+			// Keys created before rotate_after was set, or imported ones,
+			// have no rotation time yet; the next apply records it.
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotated_at"), types.StringUnknown())...)
+		} else if keyRotationDue(plan.RotateAfter.ValueString(), state.RotatedAt.ValueString(), time.Now()) {
+			rotate = true
+		}
+	}
+	if !rotate {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("key"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotated_at"), types.StringUnknown())...)
+}
+
+// keyRotationPlanned reports whether the plan regenerates the key with a
+// server-generated value: rotation_trigger changed, or planRotation found the
+// key older than rotate_after and left rotated_at unknown.
+func keyRotationPlanned(plan, state *KeyResourceModel) bool {
+	if !plan.RotationTrigger.IsNull() && !plan.RotationTrigger.Equal(state.RotationTrigger) {
+		return true
+	}
+	return !plan.RotateAfter.IsNull() && !state.RotatedAt.IsNull() && plan.RotatedAt.IsUnknown()
+}
+
+// keyValueChanged reports whether a configured key differs from the key in
+// state. Keys are compared by token, because an imported key's state holds
+// the hashed token while its configuration holds the raw value.
+func keyValueChanged(configured, current types.String) bool {
+	if configured.IsNull() {
+		return false
+	}
+	if configured.IsUnknown() {
+		return true
+	}
+	return keyToken(configured.ValueString()) != keyToken(current.ValueString())
+}
+
+// keyRotationDue reports whether a key rotated at rotatedAt is older than
+// rotateAfter at now. Unparseable values never trigger a rotation.
+func keyRotationDue(rotateAfter, rotatedAt string, now time.Time) bool {
+	after, err := parseLiteLLMDuration(rotateAfter)
+	if err != nil {
+		return false
+	}
+	at, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return false
+	}
+	return !now.Before(at.Add(after))
+}
+
 func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data KeyResourceModel
 
@@ -296,6 +582,9 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	keyReq := r.buildKeyRequest(ctx, &data)
 
 	endpoint := "/key/generate"
@@ -305,14 +594,16 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", endpoint, keyReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create key: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create key", err)
 		return
 	}
 
 	if keyVal, ok := result["key"].(string); ok {
 		data.Key = types.StringValue(keyVal)
 		data.ID = types.StringValue(hashKeyForID(keyVal))
+		data.Token = types.StringValue(keyToken(keyVal))
 	}
+	data.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 
 	// Read back for full state
 	if err := r.readKey(ctx, &data); err != nil {
@@ -320,6 +611,7 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, keyIdentity(&data))
 }
 
 func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -330,16 +622,25 @@ func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	if err := r.readKey(ctx, &data); err != nil {
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.readKey(ctx, &data)
+	if IsNotFoundError(err) && data.AutoRotate.ValueBool() && data.KeyAlias.ValueString() != "" &&
+		r.followRotatedKey(ctx, &data, &resp.Diagnostics) {
+		err = r.readKey(ctx, &data)
+	}
+	if err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read key", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, keyIdentity(&data))
 }
 
 func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -350,28 +651,59 @@ func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state KeyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The key is regenerated in place when a rotation is planned, with a
+	// server-generated value, or when the configured key changed, with that
+	// value.
+	var regenerateReq map[string]interface{}
+	if keyRotationPlanned(&data, &state) {
+		regenerateReq = map[string]interface{}{}
+	} else if keyValueChanged(data.Key, state.Key) {
+		regenerateReq = map[string]interface{}{"new_key": data.Key.ValueString()}
+	}
 	data.ID = state.ID
 	data.Key = state.Key
 
 	updateReq := r.buildKeyRequest(ctx, &data)
 	updateReq["key"] = data.Key.ValueString()
+	if data.AutoRotate.IsNull() && state.AutoRotate.ValueBool() {
+		updateReq["auto_rotate"] = false
+	}
+	applyKeyNullableClears(updateReq, &state, &data)
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/key/update", updateReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update key: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update key", err)
 		return
 	}
 
+	if regenerateReq != nil {
+		newKey, err := r.client.regenerateKey(ctx, data.Key.ValueString(), regenerateReq)
+		if err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to regenerate key", err)
+			return
+		}
+		data.Key = types.StringValue(newKey)
+		data.ID = types.StringValue(hashKeyForID(newKey))
+	}
+	data.Token = types.StringValue(keyToken(data.Key.ValueString()))
+	if data.RotatedAt.IsUnknown() {
+		data.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+
 	if err := r.readKey(ctx, &data); err != nil {
 		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Key updated but failed to read back: %s", err))
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, keyIdentity(&data))
 }
 
 func (r *KeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -382,26 +714,111 @@ func (r *KeyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"keys": []string{data.Key.ValueString()},
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/key/delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete key: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete key", err)
 			return
 		}
 	}
 }
 
 func (r *KeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// The import ID is the raw API key value. Store it in "key" (sensitive)
-	// and use a SHA256 hash as the non-sensitive resource ID.
-	rawKey := req.ID
+	// The import ID is the raw API key value, its hashed token or its
+	// key_alias; importing by identity uses the hashed token. Store the key
+	// or token in "key" (sensitive) and use a SHA256 hash as the
+	// non-sensitive resource ID.
+	rawKey := importID(ctx, req, "token", &resp.Diagnostics)
+	if req.ID != "" {
+		resolved, err := r.resolveImportKey(ctx, req.ID)
+		if err != nil {
+			addImportError(ctx, &resp.Diagnostics, req.ID, err)
+			return
+		}
+		rawKey = resolved
+	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), hashKeyForID(rawKey))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), rawKey)...)
 }
 
+// resolveImportKey returns the key to import for an import ID. Raw keys and
+// hashed tokens are used as is; anything else is looked up as a key_alias.
+func (r *KeyResource) resolveImportKey(ctx context.Context, id string) (string, error) {
+	if strings.HasPrefix(id, "sk-") || hashedTokenPattern.MatchString(id) {
+		return id, nil
+	}
+
+	lister := &KeyListResource{client: r.client}
+	config := KeyListResourceModel{
+		TeamID:         types.StringNull(),
+		UserID:         types.StringNull(),
+		OrganizationID: types.StringNull(),
+		KeyAlias:       types.StringValue(id),
+	}
+
+	var tokens []string
+	for page := 1; ; page++ {
+		keys, totalPages, err := lister.listKeys(ctx, config, page)
+		if err != nil {
+			return "", err
+		}
+		for _, key := range keys {
+			// Servers that ignore the key_alias filter return every key.
+			if alias, _ := key["key_alias"].(string); alias != id {
+				continue
+			}
+			if token, _ := key["token"].(string); token != "" {
+				tokens = append(tokens, token)
+			}
+		}
+		if page >= totalPages {
+			break
+		}
+	}
+
+	return uniqueImportMatch("key", "key_alias", id, tokens)
+}
+
+// followRotatedKey points data at the key LiteLLM's auto-rotation replaced
+// it with, found through its key_alias, and reports whether it was found.
+// Like an import, the key attribute then holds the hashed token because the
+// new raw key cannot be recovered.
+func (r *KeyResource) followRotatedKey(ctx context.Context, data *KeyResourceModel, diags *diag.Diagnostics) bool {
+	token, err := r.resolveImportKey(ctx, data.KeyAlias.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Unable to find rotated key by alias", map[string]interface{}{"error": err.Error()})
+		return false
+	}
+
+	data.Key = types.StringValue(token)
+	data.ID = types.StringValue(hashKeyForID(token))
+	diags.AddWarning("Key Rotated by LiteLLM",
+		fmt.Sprintf("The key %q was rotated by LiteLLM's auto-rotation. State now holds its hashed token since the new key value cannot be read back.", data.KeyAlias.ValueString()))
+	return true
+}
+
+// regenerateKey regenerates key through /key/{key}/regenerate, keeping its
+// alias, budgets and spend, and returns the new key.
+func (c *Client) regenerateKey(ctx context.Context, key string, body map[string]interface{}) (string, error) {
+	endpoint := fmt.Sprintf("/key/%s/regenerate", url.PathEscape(key))
+	var result map[string]interface{}
+	if err := c.DoRequestWithResponse(ctx, "POST", endpoint, body, &result); err != nil {
+		return "", err
+	}
+
+	newKey, _ := result["key"].(string)
+	if newKey == "" {
+		return "", fmt.Errorf("the regenerate response for key %s did not include the new key", keyToken(key))
+	}
+	return newKey, nil
+}
+
 // UpgradeState handles state migrations from older schema versions.
 // Version 0 → 1: The resource ID changes from the raw API key to a SHA256 hash.
 func (r *KeyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -534,6 +951,15 @@ func (r *KeyResource) buildKeyRequest(ctx context.Context, data *KeyResourceMode
 	if !data.Blocked.IsNull() && !data.Blocked.IsUnknown() {
 		keyReq["blocked"] = data.Blocked.ValueBool()
 	}
+	if !data.AutoRotate.IsNull() && !data.AutoRotate.IsUnknown() {
+		keyReq["auto_rotate"] = data.AutoRotate.ValueBool()
+	}
+	if !data.RotationInterval.IsNull() && !data.RotationInterval.IsUnknown() && data.RotationInterval.ValueString() != "" {
+		keyReq["rotation_interval"] = data.RotationInterval.ValueString()
+	}
+	if !data.DisableGlobalGuardrails.IsNull() && !data.DisableGlobalGuardrails.IsUnknown() {
+		keyReq["disable_global_guardrails"] = data.DisableGlobalGuardrails.ValueBool()
+	}
 
 	// Models list - special handling for team models
 	if !data.Models.IsNull() && !data.Models.IsUnknown() {
@@ -606,6 +1032,40 @@ func (r *KeyResource) buildKeyRequest(ctx context.Context, data *KeyResourceMode
 		}
 	}
 
+	if !data.AccessGroupIDs.IsNull() && !data.AccessGroupIDs.IsUnknown() {
+		keyReq["access_group_ids"] = listToStringSlice(data.AccessGroupIDs)
+	}
+
+	if !data.Policies.IsNull() && !data.Policies.IsUnknown() {
+		keyReq["policies"] = listToStringSlice(data.Policies)
+	}
+
+	if !data.AllowedVectorStoreIndexes.IsNull() && !data.AllowedVectorStoreIndexes.IsUnknown() {
+		var indexes []VectorStoreIndexModel
+		data.AllowedVectorStoreIndexes.ElementsAs(ctx, &indexes, false)
+		payload := make([]map[string]interface{}, 0, len(indexes))
+		for _, index := range indexes {
+			payload = append(payload, map[string]interface{}{
+				"index_name":        index.IndexName.ValueString(),
+				"index_permissions": listToStringSlice(index.IndexPermissions),
+			})
+		}
+		keyReq["allowed_vector_store_indexes"] = payload
+	}
+
+	// Nested objects
+	if !data.ObjectPermission.IsNull() && !data.ObjectPermission.IsUnknown() {
+		keyReq["object_permission"] = buildObjectPermissionPayload(ctx, data.ObjectPermission)
+	}
+
+	if !data.RouterSettings.IsNull() && !data.RouterSettings.IsUnknown() {
+		keyReq["router_settings"] = buildRouterSettingsPayload(ctx, data.RouterSettings)
+	}
+
+	if !data.BudgetLimits.IsNull() && !data.BudgetLimits.IsUnknown() {
+		keyReq["budget_limits"] = buildBudgetLimitsPayload(ctx, data.BudgetLimits)
+	}
+
 	// Map fields - check IsNull, IsUnknown, and len > 0
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
 		var metadata map[string]string
@@ -680,9 +1140,37 @@ func (r *KeyResource) buildKeyRequest(ctx context.Context, data *KeyResourceMode
 		}
 	}
 
+	r.client.mergeDefaultMetadata(keyReq)
+	r.client.mergeDefaultTags(keyReq)
+
 	return keyReq
 }
 
+// applyKeyNullableClears mutates keyReq to clear budget windows and access
+// settings that were removed from config. LiteLLM ignores omitted fields on /key/update, so
+// without this the prior values would persist.
+func applyKeyNullableClears(keyReq map[string]interface{}, state, plan *KeyResourceModel) {
+	applyObjectPermissionClears(keyReq, state.ObjectPermission, plan.ObjectPermission)
+	if !state.AccessGroupIDs.IsNull() && plan.AccessGroupIDs.IsNull() {
+		keyReq["access_group_ids"] = []string{}
+	}
+	if !state.Policies.IsNull() && plan.Policies.IsNull() {
+		keyReq["policies"] = []string{}
+	}
+	if !state.AllowedVectorStoreIndexes.IsNull() && plan.AllowedVectorStoreIndexes.IsNull() {
+		keyReq["allowed_vector_store_indexes"] = []map[string]interface{}{}
+	}
+	if !state.DisableGlobalGuardrails.IsNull() && plan.DisableGlobalGuardrails.IsNull() {
+		keyReq["disable_global_guardrails"] = false
+	}
+	if !state.RouterSettings.IsNull() && plan.RouterSettings.IsNull() {
+		keyReq["router_settings"] = map[string]interface{}{}
+	}
+	if !state.BudgetLimits.IsNull() && plan.BudgetLimits.IsNull() {
+		keyReq["budget_limits"] = nil
+	}
+}
+
 func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error {
 	keyVal := data.Key.ValueString()
 	if keyVal == "" {
@@ -738,6 +1226,13 @@ func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error
 	} else if data.Blocked.IsUnknown() {
 		data.Blocked = types.BoolNull()
 	}
+	// auto_rotate and rotation_interval are only tracked once configured.
+	if autoRotate, ok := info["auto_rotate"].(bool); ok && !data.AutoRotate.IsNull() {
+		data.AutoRotate = types.BoolValue(autoRotate)
+	}
+	if interval, ok := info["rotation_interval"].(string); ok && interval != "" && !data.RotationInterval.IsNull() {
+		data.RotationInterval = types.StringValue(interval)
+	}
 	if orgID, ok := info["organization_id"].(string); ok && orgID != "" {
 		data.OrganizationID = types.StringValue(orgID)
 	}
@@ -774,6 +1269,7 @@ func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error
 			data.BudgetDuration = types.StringValue(budgetDuration)
 		}
 	}
+	data.BudgetLimits = parseBudgetLimitsFromAPI(info["budget_limits"], data.BudgetLimits)
 	if teamID, ok := info["team_id"].(string); ok && teamID != "" {
 		data.TeamID = types.StringValue(teamID)
 	}
@@ -801,6 +1297,9 @@ func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error
 			data.ID = types.StringValue(hashKeyForID(keyValue))
 		}
 	}
+	if !data.Key.IsUnknown() && !data.Key.IsNull() {
+		data.Token = types.StringValue(keyToken(data.Key.ValueString()))
+	}
 
 	// Handle models list - preserve null when API returns empty and config didn't specify models
 	if models, ok := info["models"].([]interface{}); ok && len(models) > 0 {
@@ -904,6 +1403,8 @@ func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error
 			rawTags = tags
 		}
 	}
+	apiTags := rawTags
+	rawTags = r.client.withoutDefaultTags(ctx, rawTags, data.Tags)
 	if len(rawTags) > 0 {
 		tagsList := make([]attr.Value, 0, len(rawTags))
 		for _, t := range rawTags {
@@ -919,7 +1420,8 @@ func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error
 	// Handle metadata map - preserve null when API returns empty and config didn't specify metadata.
 	// The API may inject internal keys (e.g. tpm_limit_type, rpm_limit_type) into metadata.
 	// Only include keys that were in the user's original config to avoid drift.
-	if metadata, ok := info["metadata"].(map[string]interface{}); ok && len(metadata) > 0 {
+	apiMetadata := withoutMetadataKeys(info["metadata"], keySettingsMetadataKeys)
+	if metadata := r.client.withoutDefaultMetadata(ctx, apiMetadata, data.Metadata); len(metadata) > 0 {
 		// Build set of user-configured metadata keys
 		configuredKeys := make(map[string]bool)
 		if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
@@ -947,6 +1449,9 @@ func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error
 		data.Metadata, _ = types.MapValue(types.StringType, map[string]attr.Value{})
 	}
 
+	data.MetadataAll = r.client.metadataAllFromAPI(ctx, data.Metadata, apiMetadata)
+	data.TagsAll = r.client.tagsAllFromAPI(ctx, data.Tags, apiTags)
+
 	// Handle aliases map - preserve null when API returns empty and config didn't specify aliases
 	if aliases, ok := info["aliases"].(map[string]interface{}); ok && len(aliases) > 0 {
 		aliasMap := make(map[string]attr.Value)
@@ -1027,5 +1532,81 @@ func (r *KeyResource) readKey(ctx context.Context, data *KeyResourceModel) error
 		data.ModelTPMLimit, _ = types.MapValue(types.Int64Type, map[string]attr.Value{})
 	}
 
+	r.readKeyAccessSettings(ctx, info, data)
+
 	return nil
 }
+
+// keySettingsMetadataKeys are key settings that LiteLLM may keep in the key's
+// metadata (see readKeyAccessSettings). They have their own attributes, so
+// they are left out of metadata and metadata_all when read back.
+var keySettingsMetadataKeys = []string{
+	"policies",
+	"allowed_vector_store_indexes",
+	"disable_global_guardrails",
+}
+
+// readKeyAccessSettings reads object_permission, access_group_ids, policies,
+// allowed_vector_store_indexes, disable_global_guardrails and
+// router_settings. Depending on the LiteLLM version, policies, vector store
+// indexes and disable_global_guardrails are kept in metadata rather than at
+// the top level, so both are checked.
+func (r *KeyResource) readKeyAccessSettings(ctx context.Context, info map[string]interface{}, data *KeyResourceModel) {
+	metadata, _ := info["metadata"].(map[string]interface{})
+	field := func(name string) interface{} {
+		if v, ok := info[name]; ok && v != nil {
+			return v
+		}
+		return metadata[name]
+	}
+
+	if perm, ok := info["object_permission"].(map[string]interface{}); ok {
+		data.ObjectPermission = parseObjectPermissionFromAPI(ctx, perm, data.ObjectPermission)
+	} else if data.ObjectPermission.IsUnknown() {
+		data.ObjectPermission = types.ObjectNull(objectPermissionAttrTypes)
+	}
+
+	// Handle access_group_ids and policies lists - preserve null when API returns empty and config didn't specify them
+	if ids, ok := field("access_group_ids").([]interface{}); ok && len(ids) > 0 {
+		data.AccessGroupIDs = interfaceSliceToStringList(ids)
+	} else if !data.AccessGroupIDs.IsNull() {
+		data.AccessGroupIDs, _ = types.ListValue(types.StringType, []attr.Value{})
+	}
+	if policies, ok := field("policies").([]interface{}); ok && len(policies) > 0 {
+		data.Policies = interfaceSliceToStringList(policies)
+	} else if !data.Policies.IsNull() {
+		data.Policies, _ = types.ListValue(types.StringType, []attr.Value{})
+	}
+
+	indexType := types.ObjectType{AttrTypes: vectorStoreIndexAttrTypes}
+	if indexes, ok := field("allowed_vector_store_indexes").([]interface{}); ok && len(indexes) > 0 {
+		indexList := make([]attr.Value, 0, len(indexes))
+		for _, item := range indexes {
+			index, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := index["index_name"].(string)
+			permissions, _ := index["index_permissions"].([]interface{})
+			indexList = append(indexList, types.ObjectValueMust(vectorStoreIndexAttrTypes, map[string]attr.Value{
+				"index_name":        types.StringValue(name),
+				"index_permissions": interfaceSliceToStringList(permissions),
+			}))
+		}
+		data.AllowedVectorStoreIndexes, _ = types.ListValue(indexType, indexList)
+	} else if !data.AllowedVectorStoreIndexes.IsNull() {
+		data.AllowedVectorStoreIndexes, _ = types.ListValue(indexType, []attr.Value{})
+	}
+
+	// disable_global_guardrails defaults to false, which is only tracked once configured.
+	if disable, ok := field("disable_global_guardrails").(bool); ok && (disable || !data.DisableGlobalGuardrails.IsNull()) {
+		data.DisableGlobalGuardrails = types.BoolValue(disable)
+	}
+
+	// The API may not echo back router_settings, so only adopt them when present.
+	if rs, ok := info["router_settings"].(map[string]interface{}); ok && len(rs) > 0 {
+		data.RouterSettings = parseRouterSettingsFromAPI(rs)
+	} else if data.RouterSettings.IsUnknown() {
+		data.RouterSettings = types.ObjectNull(routerSettingsAttrTypes)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,9 +28,10 @@ type KeyBlockResource struct {
 }
 
 type KeyBlockResourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	Key     types.String `tfsdk:"key"`
-	Blocked types.Bool   `tfsdk:"blocked"`
+	ID       types.String   `tfsdk:"id"`
+	Key      types.String   `tfsdk:"key"`
+	Blocked  types.Bool     `tfsdk:"blocked"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *KeyBlockResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,6 +65,9 @@ func (r *KeyBlockResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -91,13 +96,16 @@ func (r *KeyBlockResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Block the key
 	blockReq := map[string]interface{}{
 		"key": data.Key.ValueString(),
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/key/block", blockReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to block key: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to block key", err)
 		return
 	}
 
@@ -115,6 +123,9 @@ func (r *KeyBlockResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Check if the key is still blocked
 	endpoint := fmt.Sprintf("/key/info?key=%s", data.Key.ValueString())
 
@@ -125,7 +136,7 @@ func (r *KeyBlockResource) Read(ctx context.Context, req resource.ReadRequest, r
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read key", err)
 		return
 	}
 
@@ -161,6 +172,9 @@ func (r *KeyBlockResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state KeyBlockResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -181,6 +195,9 @@ func (r *KeyBlockResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Unblock the key
 	unblockReq := map[string]interface{}{
 		"key": data.Key.ValueString(),
@@ -188,8 +205,8 @@ func (r *KeyBlockResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/key/unblock", unblockReq, nil); err != nil {
 		// Don't fail if the key doesn't exist
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unblock key: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to unblock key", err)
 			return
 		}
 	}
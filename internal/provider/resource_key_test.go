@@ -7,11 +7,16 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestHashKeyForID(t *testing.T) {
@@ -1409,3 +1414,448 @@ func TestReadKeyPopulatesUnknownKey(t *testing.T) {
 		t.Errorf("key should remain %q, got %q", apiReturnedKey, data.Key.ValueString())
 	}
 }
+
+func TestKeyPlanRotation(t *testing.T) {
+	t.Parallel()
+
+	rotatedAt := time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339)
+	tests := map[string]struct {
+		state, plan map[string]tftypes.Value
+		wantRotate  bool
+	}{
+		"trigger unchanged": {
+			state: map[string]tftypes.Value{"rotation_trigger": tftypes.NewValue(tftypes.String, "1")},
+			plan:  map[string]tftypes.Value{"rotation_trigger": tftypes.NewValue(tftypes.String, "1")},
+		},
+		"trigger changed": {
+			state:      map[string]tftypes.Value{"rotation_trigger": tftypes.NewValue(tftypes.String, "1")},
+			plan:       map[string]tftypes.Value{"rotation_trigger": tftypes.NewValue(tftypes.String, "2")},
+			wantRotate: true,
+		},
+		"trigger removed": {
+			state: map[string]tftypes.Value{"rotation_trigger": tftypes.NewValue(tftypes.String, "1")},
+		},
+		"rotate_after elapsed": {
+			state:      map[string]tftypes.Value{"rotated_at": tftypes.NewValue(tftypes.String, rotatedAt)},
+			plan:       map[string]tftypes.Value{"rotate_after": tftypes.NewValue(tftypes.String, "1d")},
+			wantRotate: true,
+		},
+		"rotate_after not elapsed": {
+			state: map[string]tftypes.Value{"rotated_at": tftypes.NewValue(tftypes.String, rotatedAt)},
+			plan:  map[string]tftypes.Value{"rotate_after": tftypes.NewValue(tftypes.String, "7d")},
+		},
+	}
+
+	for name, tt := range tests {
+		base := map[string]tftypes.Value{
+			"id":  tftypes.NewValue(tftypes.String, hashKeyForID("sk-old")),
+			"key": tftypes.NewValue(tftypes.String, "sk-old"),
+		}
+		stateValues := map[string]tftypes.Value{"rotated_at": tftypes.NewValue(tftypes.String, rotatedAt)}
+		planValues := map[string]tftypes.Value{"rotated_at": tftypes.NewValue(tftypes.String, rotatedAt)}
+		for _, m := range []map[string]tftypes.Value{stateValues, planValues} {
+			for k, v := range base {
+				m[k] = v
+			}
+		}
+		for k, v := range tt.state {
+			stateValues[k] = v
+		}
+		for k, v := range tt.plan {
+			planValues[k] = v
+		}
+
+		stateRaw, schemaResp := resourceObjectValue(t, &KeyResource{}, stateValues)
+		planRaw, _ := resourceObjectValue(t, &KeyResource{}, planValues)
+		configRaw, _ := resourceObjectValue(t, &KeyResource{}, tt.plan)
+		req := resource.ModifyPlanRequest{
+			State:  tfsdk.State{Raw: stateRaw, Schema: schemaResp.Schema},
+			Plan:   tfsdk.Plan{Raw: planRaw, Schema: schemaResp.Schema},
+			Config: tfsdk.Config{Raw: configRaw, Schema: schemaResp.Schema},
+		}
+		resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+		(&KeyResource{}).planRotation(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+
+		var key types.String
+		resp.Plan.GetAttribute(context.Background(), path.Root("key"), &key)
+		if key.IsUnknown() != tt.wantRotate {
+			t.Errorf("%s: expected rotation %t, planned key %s", name, tt.wantRotate, key)
+		}
+
+		// Update must reach the same decision from the resulting plan.
+		var plan, state KeyResourceModel
+		resp.Plan.Get(context.Background(), &plan)
+		req.State.Get(context.Background(), &state)
+		if got := keyRotationPlanned(&plan, &state); got != tt.wantRotate {
+			t.Errorf("%s: keyRotationPlanned = %t, want %t", name, got, tt.wantRotate)
+		}
+	}
+}
+
+func TestKeyPlanConfiguredKeyChange(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		configKey  tftypes.Value
+		wantNewID  bool
+		wantRegen  bool
+		stateToken bool
+	}{
+		"unchanged":               {configKey: tftypes.NewValue(tftypes.String, "sk-old")},
+		"changed":                 {configKey: tftypes.NewValue(tftypes.String, "sk-new"), wantNewID: true, wantRegen: true},
+		"unknown until apply":     {configKey: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), wantNewID: true},
+		"imported with raw value": {configKey: tftypes.NewValue(tftypes.String, "sk-old"), stateToken: true},
+	}
+
+	for name, tt := range tests {
+		stateKey := "sk-old"
+		if tt.stateToken {
+			stateKey = keyToken("sk-old")
+		}
+		stateRaw, schemaResp := resourceObjectValue(t, &KeyResource{}, map[string]tftypes.Value{
+			"id":  tftypes.NewValue(tftypes.String, hashKeyForID(stateKey)),
+			"key": tftypes.NewValue(tftypes.String, stateKey),
+		})
+		planRaw, _ := resourceObjectValue(t, &KeyResource{}, map[string]tftypes.Value{
+			"id":  tftypes.NewValue(tftypes.String, hashKeyForID(stateKey)),
+			"key": tt.configKey,
+		})
+		configRaw, _ := resourceObjectValue(t, &KeyResource{}, map[string]tftypes.Value{"key": tt.configKey})
+		req := resource.ModifyPlanRequest{
+			State:  tfsdk.State{Raw: stateRaw, Schema: schemaResp.Schema},
+			Plan:   tfsdk.Plan{Raw: planRaw, Schema: schemaResp.Schema},
+			Config: tfsdk.Config{Raw: configRaw, Schema: schemaResp.Schema},
+		}
+		resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+		(&KeyResource{}).planRotation(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", name, resp.Diagnostics)
+		}
+
+		var plan, state KeyResourceModel
+		resp.Plan.Get(context.Background(), &plan)
+		req.State.Get(context.Background(), &state)
+		if plan.ID.IsUnknown() != tt.wantNewID {
+			t.Errorf("%s: expected new id %t, planned id %s", name, tt.wantNewID, plan.ID)
+		}
+		// A key that is unknown at plan time is a configured value, never a
+		// request to generate one.
+		if keyRotationPlanned(&plan, &state) {
+			t.Errorf("%s: a configured key must not be replaced by a generated one", name)
+		}
+		if !plan.Key.IsUnknown() && keyValueChanged(plan.Key, state.Key) != tt.wantRegen {
+			t.Errorf("%s: expected regeneration with the configured key %t", name, tt.wantRegen)
+		}
+	}
+}
+
+func TestRegenerateKeyReturnsNewKey(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.EscapedPath() != "/key/sk-old%23key/regenerate" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.EscapedPath())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"key": "sk-new", "key_alias": "ci"})
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+	newKey, err := client.regenerateKey(context.Background(), "sk-old#key", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if newKey != "sk-new" {
+		t.Fatalf("expected sk-new, got %s", newKey)
+	}
+}
+
+func TestBuildKeyRequestIncludesAutoRotation(t *testing.T) {
+	t.Parallel()
+
+	r := &KeyResource{}
+	keyReq := r.buildKeyRequest(context.Background(), &KeyResourceModel{
+		AutoRotate:       types.BoolValue(true),
+		RotationInterval: types.StringValue("30d"),
+		RotationTrigger:  types.StringValue("2024-q1"),
+	})
+
+	if keyReq["auto_rotate"] != true || keyReq["rotation_interval"] != "30d" {
+		t.Fatalf("expected auto rotation settings, got %v", keyReq)
+	}
+	if _, ok := keyReq["rotation_trigger"]; ok {
+		t.Fatal("rotation_trigger is provider-side and must not be sent")
+	}
+}
+
+func TestBuildKeyRequestIncludesAccessSettings(t *testing.T) {
+	t.Parallel()
+
+	strList := func(values ...string) types.List {
+		elems := make([]attr.Value, len(values))
+		for i, v := range values {
+			elems[i] = types.StringValue(v)
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	perm := types.ObjectValueMust(objectPermissionAttrTypes, map[string]attr.Value{
+		"mcp_servers":       strList("github"),
+		"mcp_access_groups": types.ListNull(types.StringType),
+		"mcp_tool_permissions": types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{
+			"github": strList("list_issues", "get_issue"),
+		}),
+		"vector_stores": strList("vs-1"),
+		"agents":        types.ListNull(types.StringType),
+	})
+	indexes := types.ListValueMust(types.ObjectType{AttrTypes: vectorStoreIndexAttrTypes}, []attr.Value{
+		types.ObjectValueMust(vectorStoreIndexAttrTypes, map[string]attr.Value{
+			"index_name":        types.StringValue("docs"),
+			"index_permissions": strList("read"),
+		}),
+	})
+	fallbacks := types.ListValueMust(types.ObjectType{AttrTypes: fallbackEntryAttrTypes}, []attr.Value{
+		types.ObjectValueMust(fallbackEntryAttrTypes, map[string]attr.Value{
+			"model":           types.StringValue("gpt-4o"),
+			"fallback_models": strList("gpt-4o-mini"),
+		}),
+	})
+	rs := types.ObjectValueMust(routerSettingsAttrTypes, map[string]attr.Value{
+		"fallbacks":                fallbacks,
+		"context_window_fallbacks": types.ListNull(types.ObjectType{AttrTypes: fallbackEntryAttrTypes}),
+	})
+
+	r := &KeyResource{}
+	keyReq := r.buildKeyRequest(context.Background(), &KeyResourceModel{
+		ObjectPermission:          perm,
+		AccessGroupIDs:            strList("ag-1"),
+		Policies:                  strList("pii-policy"),
+		AllowedVectorStoreIndexes: indexes,
+		DisableGlobalGuardrails:   types.BoolValue(true),
+		RouterSettings:            rs,
+	})
+
+	body, err := json.Marshal(keyReq)
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+	var got map[string]interface{}
+	_ = json.Unmarshal(body, &got)
+
+	gotPerm, ok := got["object_permission"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("object_permission missing: %v", got)
+	}
+	if _, ok := gotPerm["agents"]; ok {
+		t.Errorf("unset agents should not be sent, got %v", gotPerm)
+	}
+	tools, _ := gotPerm["mcp_tool_permissions"].(map[string]interface{})
+	if githubTools, _ := tools["github"].([]interface{}); len(githubTools) != 2 || githubTools[0] != "list_issues" {
+		t.Errorf("expected mcp_tool_permissions as a list per server, got %v", gotPerm["mcp_tool_permissions"])
+	}
+	if vs, _ := gotPerm["vector_stores"].([]interface{}); len(vs) != 1 || vs[0] != "vs-1" {
+		t.Errorf("unexpected vector_stores: %v", gotPerm["vector_stores"])
+	}
+
+	gotIndexes, _ := got["allowed_vector_store_indexes"].([]interface{})
+	if len(gotIndexes) != 1 {
+		t.Fatalf("expected one vector store index, got %v", got["allowed_vector_store_indexes"])
+	}
+	if index := gotIndexes[0].(map[string]interface{}); index["index_name"] != "docs" {
+		t.Errorf("unexpected vector store index: %v", index)
+	}
+	if got["disable_global_guardrails"] != true {
+		t.Errorf("expected disable_global_guardrails true, got %v", got["disable_global_guardrails"])
+	}
+	if ids, _ := got["access_group_ids"].([]interface{}); len(ids) != 1 || ids[0] != "ag-1" {
+		t.Errorf("unexpected access_group_ids: %v", got["access_group_ids"])
+	}
+	if policies, _ := got["policies"].([]interface{}); len(policies) != 1 || policies[0] != "pii-policy" {
+		t.Errorf("unexpected policies: %v", got["policies"])
+	}
+	gotRS, _ := got["router_settings"].(map[string]interface{})
+	if fb, _ := gotRS["fallbacks"].([]interface{}); len(fb) != 1 {
+		t.Errorf("unexpected router_settings: %v", got["router_settings"])
+	}
+}
+
+func TestReadKeyReadsAccessSettings(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"key": "sk-access",
+			"info": map[string]interface{}{
+				"token":            "sk-access",
+				"access_group_ids": []interface{}{"ag-1"},
+				"metadata": map[string]interface{}{
+					"policies":                  []interface{}{"pii-policy"},
+					"disable_global_guardrails": false,
+				},
+				"object_permission": map[string]interface{}{
+					"object_permission_id": "perm-1",
+					"mcp_servers":          []interface{}{"github"},
+					"mcp_access_groups":    []interface{}{},
+					"mcp_tool_permissions": map[string]interface{}{
+						"github": []interface{}{"list_issues"},
+					},
+					"vector_stores": []interface{}{},
+					"agents":        nil,
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	r := &KeyResource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+	data := KeyResourceModel{
+		Key:                       types.StringValue("sk-access"),
+		Models:                    types.ListNull(types.StringType),
+		AllowedRoutes:             types.ListNull(types.StringType),
+		AllowedPassthroughRoutes:  types.ListNull(types.StringType),
+		AllowedCacheControls:      types.ListNull(types.StringType),
+		Guardrails:                types.ListNull(types.StringType),
+		Prompts:                   types.ListNull(types.StringType),
+		EnforcedParams:            types.ListNull(types.StringType),
+		Tags:                      types.ListNull(types.StringType),
+		Metadata:                  types.MapNull(types.StringType),
+		Aliases:                   types.MapNull(types.StringType),
+		Config:                    types.MapNull(types.StringType),
+		Permissions:               types.MapNull(types.StringType),
+		ModelMaxBudget:            types.MapNull(types.Float64Type),
+		ModelRPMLimit:             types.MapNull(types.Int64Type),
+		ModelTPMLimit:             types.MapNull(types.Int64Type),
+		ObjectPermission:          types.ObjectNull(objectPermissionAttrTypes),
+		AccessGroupIDs:            types.ListNull(types.StringType),
+		Policies:                  types.ListNull(types.StringType),
+		AllowedVectorStoreIndexes: types.ListNull(types.ObjectType{AttrTypes: vectorStoreIndexAttrTypes}),
+		DisableGlobalGuardrails:   types.BoolNull(),
+		RouterSettings:            types.ObjectNull(routerSettingsAttrTypes),
+	}
+
+	if err := r.readKey(context.Background(), &data); err != nil {
+		t.Fatalf("readKey returned error: %v", err)
+	}
+
+	var perm ObjectPermissionModel
+	if diags := data.ObjectPermission.As(context.Background(), &perm, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("unexpected object_permission: %v", diags)
+	}
+	if len(perm.MCPServers.Elements()) != 1 {
+		t.Errorf("expected mcp_servers to be read, got %v", perm.MCPServers)
+	}
+	if !perm.VectorStores.IsNull() || !perm.Agents.IsNull() || !perm.MCPAccessGroups.IsNull() {
+		t.Errorf("empty unconfigured permission lists should stay null, got %+v", perm)
+	}
+	if tools := perm.MCPToolPermissions.Elements()["github"]; tools == nil || len(tools.(types.List).Elements()) != 1 {
+		t.Errorf("expected github tool permissions, got %v", perm.MCPToolPermissions)
+	}
+	if len(data.AccessGroupIDs.Elements()) != 1 {
+		t.Errorf("expected access_group_ids to be read, got %v", data.AccessGroupIDs)
+	}
+	if len(data.Policies.Elements()) != 1 {
+		t.Errorf("expected policies to be read from metadata, got %v", data.Policies)
+	}
+	if !data.DisableGlobalGuardrails.IsNull() {
+		t.Errorf("unconfigured disable_global_guardrails false should stay null, got %v", data.DisableGlobalGuardrails)
+	}
+	if !data.AllowedVectorStoreIndexes.IsNull() || !data.RouterSettings.IsNull() {
+		t.Errorf("unset settings should stay null, got %v and %v", data.AllowedVectorStoreIndexes, data.RouterSettings)
+	}
+	if !data.Metadata.IsNull() {
+		t.Errorf("settings kept in metadata should not appear in the metadata attribute, got %v", data.Metadata)
+	}
+}
+
+func TestParseObjectPermissionFromAPIEmptyRecord(t *testing.T) {
+	t.Parallel()
+
+	raw := map[string]interface{}{"object_permission_id": "perm-1", "mcp_servers": []interface{}{}}
+	if got := parseObjectPermissionFromAPI(context.Background(), raw, types.ObjectNull(objectPermissionAttrTypes)); !got.IsNull() {
+		t.Fatalf("expected an empty record to stay null when unconfigured, got %v", got)
+	}
+}
+
+func TestApplyKeyNullableClears(t *testing.T) {
+	t.Parallel()
+
+	state := &KeyResourceModel{
+		ObjectPermission: types.ObjectValueMust(objectPermissionAttrTypes, map[string]attr.Value{
+			"mcp_servers":          types.ListValueMust(types.StringType, []attr.Value{types.StringValue("github")}),
+			"mcp_access_groups":    types.ListNull(types.StringType),
+			"mcp_tool_permissions": types.MapNull(types.ListType{ElemType: types.StringType}),
+			"vector_stores":        types.ListNull(types.StringType),
+			"agents":               types.ListNull(types.StringType),
+		}),
+		Policies:                types.ListValueMust(types.StringType, []attr.Value{types.StringValue("pii-policy")}),
+		DisableGlobalGuardrails: types.BoolValue(true),
+	}
+	plan := &KeyResourceModel{}
+
+	keyReq := map[string]interface{}{}
+	applyKeyNullableClears(keyReq, state, plan)
+
+	if perm, ok := keyReq["object_permission"].(map[string]interface{}); !ok || len(perm["mcp_servers"].([]string)) != 0 {
+		t.Errorf("expected object_permission to be cleared, got %v", keyReq["object_permission"])
+	}
+	if policies, ok := keyReq["policies"].([]string); !ok || len(policies) != 0 {
+		t.Errorf("expected policies to be cleared, got %v", keyReq["policies"])
+	}
+	if keyReq["disable_global_guardrails"] != false {
+		t.Errorf("expected disable_global_guardrails false, got %v", keyReq["disable_global_guardrails"])
+	}
+	if _, ok := keyReq["router_settings"]; ok {
+		t.Errorf("router_settings was never set and should not be sent, got %v", keyReq["router_settings"])
+	}
+}
+
+func TestApplyKeyNullableClearsObjectPermissionFields(t *testing.T) {
+	t.Parallel()
+
+	listType := types.ListType{ElemType: types.StringType}
+	state := &KeyResourceModel{
+		ObjectPermission: types.ObjectValueMust(objectPermissionAttrTypes, map[string]attr.Value{
+			"mcp_servers":          types.ListValueMust(types.StringType, []attr.Value{types.StringValue("github")}),
+			"mcp_access_groups":    types.ListNull(types.StringType),
+			"mcp_tool_permissions": types.MapValueMust(listType, map[string]attr.Value{"github": types.ListValueMust(types.StringType, []attr.Value{})}),
+			"vector_stores":        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("vs-1")}),
+			"agents":               types.ListNull(types.StringType),
+		}),
+	}
+	plan := &KeyResourceModel{
+		ObjectPermission: types.ObjectValueMust(objectPermissionAttrTypes, map[string]attr.Value{
+			"mcp_servers":          types.ListValueMust(types.StringType, []attr.Value{types.StringValue("github")}),
+			"mcp_access_groups":    types.ListNull(types.StringType),
+			"mcp_tool_permissions": types.MapNull(listType),
+			"vector_stores":        types.ListNull(types.StringType),
+			"agents":               types.ListNull(types.StringType),
+		}),
+	}
+
+	keyReq := map[string]interface{}{
+		"object_permission": buildObjectPermissionPayload(context.Background(), plan.ObjectPermission),
+	}
+	applyKeyNullableClears(keyReq, state, plan)
+
+	perm := keyReq["object_permission"].(map[string]interface{})
+	if servers, ok := perm["mcp_servers"].([]string); !ok || len(servers) != 1 {
+		t.Errorf("configured mcp_servers should be sent as is, got %v", perm["mcp_servers"])
+	}
+	if stores, ok := perm["vector_stores"].([]string); !ok || len(stores) != 0 {
+		t.Errorf("removed vector_stores should be sent empty, got %v", perm["vector_stores"])
+	}
+	if tools, ok := perm["mcp_tool_permissions"].(map[string][]string); !ok || len(tools) != 0 {
+		t.Errorf("removed mcp_tool_permissions should be sent empty, got %v", perm["mcp_tool_permissions"])
+	}
+	for _, field := range []string{"mcp_access_groups", "agents"} {
+		if _, ok := perm[field]; ok {
+			t.Errorf("%s was never set and should not be sent, got %v", field, perm[field])
+		}
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -21,7 +23,9 @@ import (
 
 var _ resource.Resource = &MCPServerResource{}
 var _ resource.ResourceWithImportState = &MCPServerResource{}
+var _ resource.ResourceWithIdentity = &MCPServerResource{}
 var _ resource.ResourceWithUpgradeState = &MCPServerResource{}
+var _ resource.ResourceWithValidateConfig = &MCPServerResource{}
 
 func NewMCPServerResource() resource.Resource {
 	return &MCPServerResource{}
@@ -69,8 +73,17 @@ type MCPServerResourceModel struct {
 	AllowAllKeys      types.Bool   `tfsdk:"allow_all_keys"`
 	SkipURLValidation types.Bool   `tfsdk:"skip_url_validation"`
 	// Computed fields
-	CreatedAt types.String `tfsdk:"created_at"`
-	CreatedBy types.String `tfsdk:"created_by"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	CreatedBy types.String   `tfsdk:"created_by"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+type MCPServerIdentityModel struct {
+	ServerID types.String `tfsdk:"server_id"`
+}
+
+func mcpServerIdentity(data *MCPServerResourceModel) MCPServerIdentityModel {
+	return MCPServerIdentityModel{ServerID: data.ID}
 }
 
 func (r *MCPServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -217,6 +230,7 @@ func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 			"mcp_info": schema.SingleNestedBlock{
 				Description: "MCP server information and configuration.",
 				Attributes: map[string]schema.Attribute{
@@ -255,6 +269,17 @@ func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r *MCPServerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"server_id": identityschema.StringAttribute{
+				Description:       "The MCP server ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *MCPServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -272,6 +297,31 @@ func (r *MCPServerResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
+func (r *MCPServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var transport, command types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("transport"), &transport)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("command"), &command)...)
+	if resp.Diagnostics.HasError() || transport.IsNull() || transport.IsUnknown() || command.IsUnknown() {
+		return
+	}
+
+	switch {
+	case transport.ValueString() == "stdio" && command.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("command"),
+			"Missing Attribute Configuration",
+			"command must be set when transport is \"stdio\".",
+		)
+	case transport.ValueString() != "stdio" && !command.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("command"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("command is only used with the \"stdio\" transport, not %q.", transport.ValueString()),
+		)
+	}
+}
+
 func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data MCPServerResourceModel
 
@@ -280,11 +330,14 @@ func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	mcpReq := r.buildMCPServerRequest(ctx, &data)
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/v1/mcp/server", mcpReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create MCP server: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create MCP server", err)
 		return
 	}
 
@@ -300,6 +353,7 @@ func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, mcpServerIdentity(&data))
 }
 
 func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -310,16 +364,20 @@ func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readMCPServer(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MCP server: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read MCP server", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, mcpServerIdentity(&data))
 }
 
 func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -330,6 +388,9 @@ func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state MCPServerResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -344,7 +405,7 @@ func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateReque
 	mcpReq["server_id"] = data.ServerID.ValueString()
 
 	if err := r.client.DoRequestWithResponse(ctx, "PUT", "/v1/mcp/server", mcpReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MCP server: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update MCP server", err)
 		return
 	}
 
@@ -354,6 +415,7 @@ func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, mcpServerIdentity(&data))
 }
 
 func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -364,6 +426,9 @@ func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	serverID := data.ID.ValueString()
 	if serverID == "" {
 		serverID = data.ServerID.ValueString()
@@ -371,16 +436,51 @@ func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	endpoint := fmt.Sprintf("/v1/mcp/server/%s", serverID)
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete MCP server: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete MCP server", err)
 			return
 		}
 	}
 }
 
 func (r *MCPServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+	// The import ID is the server ID or its server_name.
+	serverID := importID(ctx, req, "server_id", &resp.Diagnostics)
+	if req.ID != "" {
+		resolved, err := r.resolveImportServerID(ctx, req.ID)
+		if err != nil {
+			addImportError(ctx, &resp.Diagnostics, req.ID, err)
+			return
+		}
+		serverID = resolved
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+}
+
+// resolveImportServerID returns the ID of the MCP server whose ID or
+// server_name is id. An ID that matches no listed server is returned
+// unchanged, as is any ID when the servers cannot be listed.
+func (r *MCPServerResource) resolveImportServerID(ctx context.Context, id string) (string, error) {
+	servers, err := (&MCPServerListResource{client: r.client}).listMCPServers(ctx)
+	if err != nil {
+		return importIDAfterListError(ctx, "MCP server", id, err)
+	}
+
+	var matches []string
+	for _, server := range servers {
+		serverID, _ := server["server_id"].(string)
+		if serverID == id {
+			return serverID, nil
+		}
+		if name, _ := server["server_name"].(string); name == id && serverID != "" {
+			matches = append(matches, serverID)
+		}
+	}
+	if len(matches) == 0 {
+		return id, nil
+	}
+	return uniqueImportMatch("MCP server", "server_name", id, matches)
 }
 
 // UpgradeState handles state migrations from older schema versions.
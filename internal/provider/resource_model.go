@@ -6,24 +6,32 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ModelResource{}
 var _ resource.ResourceWithImportState = &ModelResource{}
+var _ resource.ResourceWithIdentity = &ModelResource{}
+var _ resource.ResourceWithModifyPlan = &ModelResource{}
+var _ resource.ResourceWithConfigValidators = &ModelResource{}
+var _ resource.ResourceWithValidateConfig = &ModelResource{}
 
 func NewModelResource() resource.Resource {
 	return &ModelResource{}
@@ -36,39 +44,55 @@ type ModelResource struct {
 
 // ModelResourceModel describes the resource data model.
 type ModelResourceModel struct {
-	ID                             types.String  `tfsdk:"id"`
-	ModelName                      types.String  `tfsdk:"model_name"`
-	CustomLLMProvider              types.String  `tfsdk:"custom_llm_provider"`
-	TPM                            types.Int64   `tfsdk:"tpm"`
-	RPM                            types.Int64   `tfsdk:"rpm"`
-	ReasoningEffort                types.String  `tfsdk:"reasoning_effort"`
-	ThinkingEnabled                types.Bool    `tfsdk:"thinking_enabled"`
-	ThinkingBudgetTokens           types.Int64   `tfsdk:"thinking_budget_tokens"`
-	MergeReasoningContentInChoices types.Bool    `tfsdk:"merge_reasoning_content_in_choices"`
-	ModelAPIKey                    types.String  `tfsdk:"model_api_key"`
-	ModelAPIBase                   types.String  `tfsdk:"model_api_base"`
-	APIVersion                     types.String  `tfsdk:"api_version"`
-	BaseModel                      types.String  `tfsdk:"base_model"`
-	Tier                           types.String  `tfsdk:"tier"`
-	TeamID                         types.String  `tfsdk:"team_id"`
-	Mode                           types.String  `tfsdk:"mode"`
-	LiteLLMCredentialName          types.String  `tfsdk:"litellm_credential_name"`
-	InputCostPerMillionTokens      types.Float64 `tfsdk:"input_cost_per_million_tokens"`
-	OutputCostPerMillionTokens     types.Float64 `tfsdk:"output_cost_per_million_tokens"`
-	InputCostPerPixel              types.Float64 `tfsdk:"input_cost_per_pixel"`
-	OutputCostPerPixel             types.Float64 `tfsdk:"output_cost_per_pixel"`
-	InputCostPerSecond             types.Float64 `tfsdk:"input_cost_per_second"`
-	OutputCostPerSecond            types.Float64 `tfsdk:"output_cost_per_second"`
-	AWSAccessKeyID                 types.String  `tfsdk:"aws_access_key_id"`
-	AWSSecretAccessKey             types.String  `tfsdk:"aws_secret_access_key"`
-	AWSRegionName                  types.String  `tfsdk:"aws_region_name"`
-	AWSSessionName                 types.String  `tfsdk:"aws_session_name"`
-	AWSRoleName                    types.String  `tfsdk:"aws_role_name"`
-	VertexProject                  types.String  `tfsdk:"vertex_project"`
-	VertexLocation                 types.String  `tfsdk:"vertex_location"`
-	VertexCredentials              types.String  `tfsdk:"vertex_credentials"`
-	AccessGroups                   types.List    `tfsdk:"access_groups"`
-	AdditionalLiteLLMParams        types.Map     `tfsdk:"additional_litellm_params"`
+	ID                             types.String   `tfsdk:"id"`
+	ModelName                      types.String   `tfsdk:"model_name"`
+	CustomLLMProvider              types.String   `tfsdk:"custom_llm_provider"`
+	TPM                            types.Int64    `tfsdk:"tpm"`
+	RPM                            types.Int64    `tfsdk:"rpm"`
+	ReasoningEffort                types.String   `tfsdk:"reasoning_effort"`
+	ThinkingEnabled                types.Bool     `tfsdk:"thinking_enabled"`
+	ThinkingBudgetTokens           types.Int64    `tfsdk:"thinking_budget_tokens"`
+	MergeReasoningContentInChoices types.Bool     `tfsdk:"merge_reasoning_content_in_choices"`
+	ModelAPIKey                    types.String   `tfsdk:"model_api_key"`
+	ModelAPIKeyWO                  types.String   `tfsdk:"model_api_key_wo"`
+	ModelAPIKeyWOVersion           types.Int64    `tfsdk:"model_api_key_wo_version"`
+	ModelAPIBase                   types.String   `tfsdk:"model_api_base"`
+	APIVersion                     types.String   `tfsdk:"api_version"`
+	BaseModel                      types.String   `tfsdk:"base_model"`
+	Tier                           types.String   `tfsdk:"tier"`
+	TeamID                         types.String   `tfsdk:"team_id"`
+	Mode                           types.String   `tfsdk:"mode"`
+	LiteLLMCredentialName          types.String   `tfsdk:"litellm_credential_name"`
+	InputCostPerMillionTokens      types.Float64  `tfsdk:"input_cost_per_million_tokens"`
+	OutputCostPerMillionTokens     types.Float64  `tfsdk:"output_cost_per_million_tokens"`
+	InputCostPerPixel              types.Float64  `tfsdk:"input_cost_per_pixel"`
+	OutputCostPerPixel             types.Float64  `tfsdk:"output_cost_per_pixel"`
+	InputCostPerSecond             types.Float64  `tfsdk:"input_cost_per_second"`
+	OutputCostPerSecond            types.Float64  `tfsdk:"output_cost_per_second"`
+	AWSAccessKeyID                 types.String   `tfsdk:"aws_access_key_id"`
+	AWSSecretAccessKey             types.String   `tfsdk:"aws_secret_access_key"`
+	AWSSecretAccessKeyWO           types.String   `tfsdk:"aws_secret_access_key_wo"`
+	AWSSecretAccessKeyWOVersion    types.Int64    `tfsdk:"aws_secret_access_key_wo_version"`
+	AWSRegionName                  types.String   `tfsdk:"aws_region_name"`
+	AWSSessionName                 types.String   `tfsdk:"aws_session_name"`
+	AWSRoleName                    types.String   `tfsdk:"aws_role_name"`
+	VertexProject                  types.String   `tfsdk:"vertex_project"`
+	VertexLocation                 types.String   `tfsdk:"vertex_location"`
+	VertexCredentials              types.String   `tfsdk:"vertex_credentials"`
+	VertexCredentialsWO            types.String   `tfsdk:"vertex_credentials_wo"`
+	VertexCredentialsWOVersion     types.Int64    `tfsdk:"vertex_credentials_wo_version"`
+	AccessGroups                   types.List     `tfsdk:"access_groups"`
+	AdditionalLiteLLMParams        types.Map      `tfsdk:"additional_litellm_params"`
+	MetadataAll                    types.Map      `tfsdk:"metadata_all"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
+}
+
+type ModelIdentityModel struct {
+	ModelID types.String `tfsdk:"model_id"`
+}
+
+func modelIdentity(data *ModelResourceModel) ModelIdentityModel {
+	return ModelIdentityModel{ModelID: data.ID}
 }
 
 func (r *ModelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -127,6 +151,8 @@ func (r *ModelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"model_api_key_wo":         writeOnlyStringAttribute("API key for the model provider.", "model_api_key"),
+			"model_api_key_wo_version": writeOnlyVersionAttribute("model_api_key_wo"),
 			"model_api_base": schema.StringAttribute{
 				Description: "Base URL for the model API.",
 				Optional:    true,
@@ -195,6 +221,8 @@ func (r *ModelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"aws_secret_access_key_wo":         writeOnlyStringAttribute("AWS secret access key for Bedrock.", "aws_secret_access_key"),
+			"aws_secret_access_key_wo_version": writeOnlyVersionAttribute("aws_secret_access_key_wo"),
 			"aws_region_name": schema.StringAttribute{
 				Description: "AWS region name for Bedrock.",
 				Optional:    true,
@@ -223,6 +251,8 @@ func (r *ModelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "Google Cloud credentials for Vertex AI.",
 				Optional:    true,
 			},
+			"vertex_credentials_wo":         writeOnlyStringAttribute("Google Cloud credentials for Vertex AI.", "vertex_credentials"),
+			"vertex_credentials_wo_version": writeOnlyVersionAttribute("vertex_credentials_wo"),
 			"access_groups": schema.ListAttribute{
 				Description: "List of access groups this model belongs to. Teams and keys with access to these groups can use this model.",
 				Optional:    true,
@@ -235,6 +265,21 @@ func (r *ModelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"metadata_all": metadataAllSchemaAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *ModelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"model_id": identityschema.StringAttribute{
+				Description:       "The model ID.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -256,6 +301,43 @@ func (r *ModelResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+func (r *ModelResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	// A credential supplies the provider secrets, so inline AWS secrets would
+	// be silently overridden by it.
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(path.MatchRoot("litellm_credential_name"), path.MatchRoot("aws_access_key_id")),
+		resourcevalidator.Conflicting(path.MatchRoot("litellm_credential_name"), path.MatchRoot("aws_secret_access_key")),
+		resourcevalidator.Conflicting(path.MatchRoot("litellm_credential_name"), path.MatchRoot("aws_secret_access_key_wo")),
+	}
+}
+
+func (r *ModelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var thinkingEnabled types.Bool
+	var thinkingBudgetTokens types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("thinking_enabled"), &thinkingEnabled)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("thinking_budget_tokens"), &thinkingBudgetTokens)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// thinking_budget_tokens is only sent when thinking is enabled.
+	if thinkingBudgetTokens.IsNull() || thinkingBudgetTokens.IsUnknown() || thinkingEnabled.IsUnknown() {
+		return
+	}
+	if !thinkingEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("thinking_budget_tokens"),
+			"Invalid Attribute Combination",
+			"thinking_budget_tokens has no effect unless thinking_enabled is true.",
+		)
+	}
+}
+
+func (r *ModelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.planDefaults(ctx, req, resp, "", false)
+}
+
 func (r *ModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ModelResourceModel
 
@@ -264,26 +346,36 @@ func (r *ModelResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Normalise numeric strings in additional_litellm_params so that the
 	// planned value uses the same canonical form as the read-back value.
 	data.AdditionalLiteLLMParams = normalizeAdditionalParams(ctx, data.AdditionalLiteLLMParams)
 
+	resp.Diagnostics.Append(r.applyWriteOnlyConfig(ctx, req.Config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	modelID := uuid.New().String()
 
 	if err := r.createOrUpdateModel(ctx, &data, modelID, false); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create model: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create model", err)
 		return
 	}
 
 	data.ID = types.StringValue(modelID)
 
 	// Read back to ensure consistency
-	if err := r.readModelWithRetry(ctx, &data, 8); err != nil {
+	if err := r.readModelWithRetry(ctx, &data, readBackAttempts(ctx, 8)); err != nil {
 		finalizeModelComputedDefaults(&data)
 		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Model created but failed to read back: %s", err))
 	}
 
+	clearModelWriteOnly(&data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, modelIdentity(&data))
 }
 
 func (r *ModelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -294,17 +386,21 @@ func (r *ModelResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	err := r.readModelWithRetry(ctx, &data, 8)
 	if err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read model: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read model", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, modelIdentity(&data))
 }
 
 func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -315,10 +411,18 @@ func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Normalise numeric strings in additional_litellm_params so that the
 	// planned value uses the same canonical form as the read-back value.
 	data.AdditionalLiteLLMParams = normalizeAdditionalParams(ctx, data.AdditionalLiteLLMParams)
 
+	resp.Diagnostics.Append(r.applyWriteOnlyConfig(ctx, req.Config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state ModelResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -329,7 +433,7 @@ func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Use PATCH endpoint for partial updates
 	if err := r.patchModel(ctx, &data); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update model: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update model", err)
 		return
 	}
 
@@ -338,7 +442,9 @@ func (r *ModelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Model updated but failed to read back: %s", err))
 	}
 
+	clearModelWriteOnly(&data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, modelIdentity(&data))
 }
 
 func (r *ModelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -349,22 +455,83 @@ func (r *ModelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]string{"id": data.ID.ValueString()}
 	err := r.client.DoRequestWithResponse(ctx, "POST", "/model/delete", deleteReq, nil)
-	if err != nil && !IsNotFoundError(err) {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete model: %s", err))
+	if err != nil && !isAlreadyDeletedError(err) {
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete model", err)
 		return
 	}
 }
 
 func (r *ModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is the model ID, its model_name, or
+	// "<team_id>:<model_name>" for a team model.
+	modelID := importID(ctx, req, "model_id", &resp.Diagnostics)
+	if req.ID != "" {
+		resolved, err := r.resolveImportModelID(ctx, req.ID)
+		if err != nil {
+			addImportError(ctx, &resp.Diagnostics, req.ID, err)
+			return
+		}
+		modelID = resolved
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), modelID)...)
+}
+
+// resolveImportModelID returns the ID of the model whose ID or model_name is
+// id. Team models are matched on their public model name, and a
+// "<team_id>:<model_name>" ID only matches models of that team. An ID that
+// matches no listed model is returned unchanged, as is any ID when the models
+// cannot be listed.
+func (r *ModelResource) resolveImportModelID(ctx context.Context, id string) (string, error) {
+	models, err := (&ModelListResource{client: r.client}).listModels(ctx, ModelListResourceModel{
+		TeamID:            types.StringNull(),
+		CustomLLMProvider: types.StringNull(),
+	})
+	if err != nil {
+		return importIDAfterListError(ctx, "model", id, err)
+	}
+
+	teamID, teamModelName, hasTeam := strings.Cut(id, ":")
+	var nameMatches, teamMatches []string
+	for _, model := range models {
+		modelInfo, _ := model["model_info"].(map[string]interface{})
+		modelID, _ := modelInfo["id"].(string)
+		if modelID == "" {
+			continue
+		}
+		if modelID == id {
+			return modelID, nil
+		}
+		name := listedModelName(model, modelID)
+		if name == id {
+			nameMatches = append(nameMatches, modelID)
+		}
+		if modelTeamID, _ := modelInfo["team_id"].(string); hasTeam && modelTeamID == teamID && name == teamModelName {
+			teamMatches = append(teamMatches, modelID)
+		}
+	}
+
+	switch {
+	case len(nameMatches) > 0:
+		if len(nameMatches) > 1 {
+			return "", fmt.Errorf("model_name %q is ambiguous: it matches %d models (%s). Import by model ID or as \"<team_id>:<model_name>\" instead",
+				id, len(nameMatches), strings.Join(nameMatches, ", "))
+		}
+		return nameMatches[0], nil
+	case len(teamMatches) > 0:
+		return uniqueImportMatch("model", "model_name", id, teamMatches)
+	}
+	return id, nil
 }
 
 func (r *ModelResource) createOrUpdateModel(ctx context.Context, data *ModelResourceModel, modelID string, isUpdate bool) error {
 	customLLMProvider := data.CustomLLMProvider.ValueString()
 	baseModel := data.BaseModel.ValueString()
-	modelName := fmt.Sprintf("%s/%s", customLLMProvider, baseModel)
+	modelName := modelRoute(customLLMProvider, baseModel)
 
 	litellmParams := map[string]interface{}{
 		"custom_llm_provider": customLLMProvider,
@@ -386,8 +553,8 @@ func (r *ModelResource) createOrUpdateModel(ctx context.Context, data *ModelReso
 	if !data.RPM.IsNull() && !data.RPM.IsUnknown() && data.RPM.ValueInt64() > 0 {
 		litellmParams["rpm"] = data.RPM.ValueInt64()
 	}
-	if !data.ModelAPIKey.IsNull() && !data.ModelAPIKey.IsUnknown() && data.ModelAPIKey.ValueString() != "" {
-		litellmParams["api_key"] = data.ModelAPIKey.ValueString()
+	if v, ok := secretValue(data.ModelAPIKey, data.ModelAPIKeyWO); ok {
+		litellmParams["api_key"] = v
 	}
 	if !data.ModelAPIBase.IsNull() && !data.ModelAPIBase.IsUnknown() && data.ModelAPIBase.ValueString() != "" {
 		litellmParams["api_base"] = data.ModelAPIBase.ValueString()
@@ -414,8 +581,8 @@ func (r *ModelResource) createOrUpdateModel(ctx context.Context, data *ModelReso
 	if !data.AWSAccessKeyID.IsNull() && !data.AWSAccessKeyID.IsUnknown() && data.AWSAccessKeyID.ValueString() != "" {
 		litellmParams["aws_access_key_id"] = data.AWSAccessKeyID.ValueString()
 	}
-	if !data.AWSSecretAccessKey.IsNull() && !data.AWSSecretAccessKey.IsUnknown() && data.AWSSecretAccessKey.ValueString() != "" {
-		litellmParams["aws_secret_access_key"] = data.AWSSecretAccessKey.ValueString()
+	if v, ok := secretValue(data.AWSSecretAccessKey, data.AWSSecretAccessKeyWO); ok {
+		litellmParams["aws_secret_access_key"] = v
 	}
 	if !data.AWSRegionName.IsNull() && !data.AWSRegionName.IsUnknown() && data.AWSRegionName.ValueString() != "" {
 		litellmParams["aws_region_name"] = data.AWSRegionName.ValueString()
@@ -434,8 +601,8 @@ func (r *ModelResource) createOrUpdateModel(ctx context.Context, data *ModelReso
 	if !data.VertexLocation.IsNull() && !data.VertexLocation.IsUnknown() && data.VertexLocation.ValueString() != "" {
 		litellmParams["vertex_location"] = data.VertexLocation.ValueString()
 	}
-	if !data.VertexCredentials.IsNull() && !data.VertexCredentials.IsUnknown() && data.VertexCredentials.ValueString() != "" {
-		litellmParams["vertex_credentials"] = data.VertexCredentials.ValueString()
+	if v, ok := secretValue(data.VertexCredentials, data.VertexCredentialsWO); ok {
+		litellmParams["vertex_credentials"] = v
 	}
 
 	// Credential reference
@@ -495,6 +662,8 @@ func (r *ModelResource) createOrUpdateModel(ctx context.Context, data *ModelReso
 		}
 	}
 
+	r.client.mergeDefaultModelInfo(modelInfo)
+
 	modelReq := map[string]interface{}{
 		"model_name":     data.ModelName.ValueString(),
 		"litellm_params": litellmParams,
@@ -721,6 +890,9 @@ func (r *ModelResource) readModel(ctx context.Context, data *ModelResourceModel)
 		data.AccessGroups, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
 
+	// The provider's default_metadata is stored as top-level model_info fields.
+	data.MetadataAll = r.client.metadataAllFromAPI(ctx, types.MapNull(types.StringType), modelInfo)
+
 	// Ensure mode is never Unknown after a Read. Terraform requires all
 	// Computed attributes to resolve to a known (or null) value after apply.
 	// Wildcard routes (e.g. openai/*) may not have a mode set in the API
@@ -733,6 +905,25 @@ func (r *ModelResource) readModel(ctx context.Context, data *ModelResourceModel)
 	return nil
 }
 
+// applyWriteOnlyConfig copies the write-only secrets from config into data so
+// the request builders can send them.
+func (r *ModelResource) applyWriteOnlyConfig(ctx context.Context, config tfsdk.Config, data *ModelResourceModel) diag.Diagnostics {
+	var cfg ModelResourceModel
+	diags := config.Get(ctx, &cfg)
+	data.ModelAPIKeyWO = cfg.ModelAPIKeyWO
+	data.AWSSecretAccessKeyWO = cfg.AWSSecretAccessKeyWO
+	data.VertexCredentialsWO = cfg.VertexCredentialsWO
+	return diags
+}
+
+// clearModelWriteOnly nulls the write-only secrets before data is saved to
+// state.
+func clearModelWriteOnly(data *ModelResourceModel) {
+	data.ModelAPIKeyWO = types.StringNull()
+	data.AWSSecretAccessKeyWO = types.StringNull()
+	data.VertexCredentialsWO = types.StringNull()
+}
+
 func finalizeModelComputedDefaults(data *ModelResourceModel) {
 	if data.Mode.IsUnknown() {
 		data.Mode = types.StringNull()
@@ -743,33 +934,15 @@ func finalizeModelComputedDefaults(data *ModelResourceModel) {
 	if data.AdditionalLiteLLMParams.IsUnknown() {
 		data.AdditionalLiteLLMParams, _ = types.MapValue(types.StringType, map[string]attr.Value{})
 	}
+	if data.MetadataAll.IsUnknown() {
+		data.MetadataAll = types.MapNull(types.StringType)
+	}
 }
 
 func (r *ModelResource) readModelWithRetry(ctx context.Context, data *ModelResourceModel, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		err = r.readModel(ctx, data)
-		if err == nil {
-			return nil
-		}
-
-		if !IsNotFoundError(err) {
-			return err
-		}
-
-		if i < maxRetries-1 {
-			time.Sleep(delay)
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
-	return err
+	return r.client.retryWhile(ctx, maxRetries, IsNotFoundError, func() error {
+		return r.readModel(ctx, data)
+	})
 }
 
 // patchModel uses the PATCH /model/{model_id}/update endpoint for partial updates
@@ -777,7 +950,7 @@ func (r *ModelResource) patchModel(ctx context.Context, data *ModelResourceModel
 	modelID := data.ID.ValueString()
 	customLLMProvider := data.CustomLLMProvider.ValueString()
 	baseModel := data.BaseModel.ValueString()
-	modelName := fmt.Sprintf("%s/%s", customLLMProvider, baseModel)
+	modelName := modelRoute(customLLMProvider, baseModel)
 
 	// Build litellm_params for the patch request.
 	// NOTE: LiteLLM PATCH API merges litellm_params (via dict.update), it does not replace them.
@@ -803,8 +976,8 @@ func (r *ModelResource) patchModel(ctx context.Context, data *ModelResourceModel
 	if !data.RPM.IsNull() && !data.RPM.IsUnknown() && data.RPM.ValueInt64() > 0 {
 		litellmParams["rpm"] = data.RPM.ValueInt64()
 	}
-	if !data.ModelAPIKey.IsNull() && !data.ModelAPIKey.IsUnknown() && data.ModelAPIKey.ValueString() != "" {
-		litellmParams["api_key"] = data.ModelAPIKey.ValueString()
+	if v, ok := secretValue(data.ModelAPIKey, data.ModelAPIKeyWO); ok {
+		litellmParams["api_key"] = v
 	}
 	if !data.ModelAPIBase.IsNull() && !data.ModelAPIBase.IsUnknown() && data.ModelAPIBase.ValueString() != "" {
 		litellmParams["api_base"] = data.ModelAPIBase.ValueString()
@@ -831,8 +1004,8 @@ func (r *ModelResource) patchModel(ctx context.Context, data *ModelResourceModel
 	if !data.AWSAccessKeyID.IsNull() && !data.AWSAccessKeyID.IsUnknown() && data.AWSAccessKeyID.ValueString() != "" {
 		litellmParams["aws_access_key_id"] = data.AWSAccessKeyID.ValueString()
 	}
-	if !data.AWSSecretAccessKey.IsNull() && !data.AWSSecretAccessKey.IsUnknown() && data.AWSSecretAccessKey.ValueString() != "" {
-		litellmParams["aws_secret_access_key"] = data.AWSSecretAccessKey.ValueString()
+	if v, ok := secretValue(data.AWSSecretAccessKey, data.AWSSecretAccessKeyWO); ok {
+		litellmParams["aws_secret_access_key"] = v
 	}
 	if !data.AWSRegionName.IsNull() && !data.AWSRegionName.IsUnknown() && data.AWSRegionName.ValueString() != "" {
 		litellmParams["aws_region_name"] = data.AWSRegionName.ValueString()
@@ -851,8 +1024,8 @@ func (r *ModelResource) patchModel(ctx context.Context, data *ModelResourceModel
 	if !data.VertexLocation.IsNull() && !data.VertexLocation.IsUnknown() && data.VertexLocation.ValueString() != "" {
 		litellmParams["vertex_location"] = data.VertexLocation.ValueString()
 	}
-	if !data.VertexCredentials.IsNull() && !data.VertexCredentials.IsUnknown() && data.VertexCredentials.ValueString() != "" {
-		litellmParams["vertex_credentials"] = data.VertexCredentials.ValueString()
+	if v, ok := secretValue(data.VertexCredentials, data.VertexCredentialsWO); ok {
+		litellmParams["vertex_credentials"] = v
 	}
 
 	// Credential reference
@@ -912,6 +1085,8 @@ func (r *ModelResource) patchModel(ctx context.Context, data *ModelResourceModel
 		}
 	}
 
+	r.client.mergeDefaultModelInfo(modelInfo)
+
 	// Build the PATCH request body
 	patchReq := map[string]interface{}{
 		"model_name":     data.ModelName.ValueString(),
@@ -954,6 +1129,23 @@ func normalizeAdditionalParams(ctx context.Context, m types.Map) types.Map {
 	return result
 }
 
+// modelRoute returns the litellm_params.model route for a provider and base
+// model, e.g. "openai/gpt-4o".
+func modelRoute(customLLMProvider, baseModel string) string {
+	return fmt.Sprintf("%s/%s", customLLMProvider, baseModel)
+}
+
+// splitModelRoute splits a route built by modelRoute into the provider and
+// base model. Only the first "/" separates them, so base models that contain
+// slashes themselves (e.g. "openrouter/anthropic/claude-3") round-trip.
+func splitModelRoute(route string) (customLLMProvider, baseModel string, ok bool) {
+	customLLMProvider, baseModel, ok = strings.Cut(route, "/")
+	if !ok || customLLMProvider == "" || baseModel == "" {
+		return "", "", false
+	}
+	return customLLMProvider, baseModel, true
+}
+
 // convertStringValue converts a string to its most appropriate Go type.
 // This allows additional_litellm_params values (which are stored as strings in
 // Terraform state) to be sent as native JSON types in the API request.
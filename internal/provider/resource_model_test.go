@@ -1010,3 +1010,62 @@ func TestReadModelImportReadsAllAdditionalParams(t *testing.T) {
 		t.Fatal("custom_flag missing after import")
 	}
 }
+
+func TestCreateModelSendsWriteOnlySecrets(t *testing.T) {
+	t.Parallel()
+
+	var capturedBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			_ = json.NewDecoder(r.Body).Decode(&capturedBody)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": "ok"})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	r := &ModelResource{
+		client: &Client{
+			APIBase:    server.URL,
+			APIKey:     "test-key",
+			HTTPClient: server.Client(),
+		},
+	}
+
+	data := &ModelResourceModel{
+		ModelName:               types.StringValue("test-model"),
+		CustomLLMProvider:       types.StringValue("bedrock"),
+		BaseModel:               types.StringValue("anthropic.claude-v2"),
+		ModelAPIKeyWO:           types.StringValue("sk-write-only"),
+		AWSSecretAccessKeyWO:    types.StringValue("aws-write-only"),
+		VertexCredentialsWO:     types.StringNull(),
+		AdditionalLiteLLMParams: types.MapNull(types.StringType),
+		AccessGroups:            types.ListNull(types.StringType),
+	}
+
+	if err := r.createOrUpdateModel(context.Background(), data, "test-id", false); err != nil {
+		t.Fatalf("createOrUpdateModel returned error: %v", err)
+	}
+
+	litellmParams, ok := capturedBody["litellm_params"].(map[string]interface{})
+	if !ok {
+		t.Fatal("litellm_params not found in request body")
+	}
+	if v := litellmParams["api_key"]; v != "sk-write-only" {
+		t.Errorf("expected api_key from model_api_key_wo, got %v", v)
+	}
+	if v := litellmParams["aws_secret_access_key"]; v != "aws-write-only" {
+		t.Errorf("expected aws_secret_access_key from aws_secret_access_key_wo, got %v", v)
+	}
+	if _, ok := litellmParams["vertex_credentials"]; ok {
+		t.Error("vertex_credentials should not be sent when unset")
+	}
+
+	clearModelWriteOnly(data)
+	if !data.ModelAPIKeyWO.IsNull() || !data.AWSSecretAccessKeyWO.IsNull() {
+		t.Error("write-only values must be cleared before saving state")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +16,7 @@ import (
 
 var _ resource.Resource = &OrganizationResource{}
 var _ resource.ResourceWithImportState = &OrganizationResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationResource{}
 
 func NewOrganizationResource() resource.Resource {
 	return &OrganizationResource{}
@@ -25,21 +27,24 @@ type OrganizationResource struct {
 }
 
 type OrganizationResourceModel struct {
-	ID                types.String  `tfsdk:"id"`
-	OrganizationID    types.String  `tfsdk:"organization_id"`
-	OrganizationAlias types.String  `tfsdk:"organization_alias"`
-	Models            types.List    `tfsdk:"models"`
-	BudgetID          types.String  `tfsdk:"budget_id"`
-	MaxBudget         types.Float64 `tfsdk:"max_budget"`
-	TPMLimit          types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit          types.Int64   `tfsdk:"rpm_limit"`
-	ModelRPMLimit     types.Map     `tfsdk:"model_rpm_limit"`
-	ModelTPMLimit     types.Map     `tfsdk:"model_tpm_limit"`
-	BudgetDuration    types.String  `tfsdk:"budget_duration"`
-	Metadata          types.Map     `tfsdk:"metadata"`
-	Blocked           types.Bool    `tfsdk:"blocked"`
-	Tags              types.List    `tfsdk:"tags"`
-	CreatedAt         types.String  `tfsdk:"created_at"`
+	ID                types.String   `tfsdk:"id"`
+	OrganizationID    types.String   `tfsdk:"organization_id"`
+	OrganizationAlias types.String   `tfsdk:"organization_alias"`
+	Models            types.List     `tfsdk:"models"`
+	BudgetID          types.String   `tfsdk:"budget_id"`
+	MaxBudget         types.Float64  `tfsdk:"max_budget"`
+	TPMLimit          types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit          types.Int64    `tfsdk:"rpm_limit"`
+	ModelRPMLimit     types.Map      `tfsdk:"model_rpm_limit"`
+	ModelTPMLimit     types.Map      `tfsdk:"model_tpm_limit"`
+	BudgetDuration    types.String   `tfsdk:"budget_duration"`
+	Metadata          types.Map      `tfsdk:"metadata"`
+	Blocked           types.Bool     `tfsdk:"blocked"`
+	Tags              types.List     `tfsdk:"tags"`
+	MetadataAll       types.Map      `tfsdk:"metadata_all"`
+	TagsAll           types.List     `tfsdk:"tags_all"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *OrganizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -119,6 +124,8 @@ func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRe
 				Optional:    true,
 				Computed:    true,
 			},
+			"metadata_all": metadataAllSchemaAttribute(),
+			"tags_all":     tagsAllSchemaAttribute(),
 			"tags": schema.ListAttribute{
 				Description: "Tags for tracking spend and/or tag-based routing.",
 				Optional:    true,
@@ -133,6 +140,9 @@ func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -153,6 +163,10 @@ func (r *OrganizationResource) Configure(ctx context.Context, req resource.Confi
 	r.client = client
 }
 
+func (r *OrganizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.planDefaults(ctx, req, resp, "metadata", true)
+}
+
 func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data OrganizationResourceModel
 
@@ -161,11 +175,14 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	orgReq := r.buildOrganizationRequest(ctx, &data)
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/organization/new", orgReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organization: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create organization", err)
 		return
 	}
 
@@ -191,12 +208,15 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readOrganization(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read organization", err)
 		return
 	}
 
@@ -211,6 +231,9 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state OrganizationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -225,7 +248,7 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	orgReq["organization_id"] = data.OrganizationID.ValueString()
 
 	if err := r.client.DoRequestWithResponse(ctx, "PATCH", "/organization/update", orgReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update organization", err)
 		return
 	}
 
@@ -245,13 +268,16 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"organization_ids": []string{data.OrganizationID.ValueString()},
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", "/organization/delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete organization: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete organization", err)
 			return
 		}
 	}
@@ -336,6 +362,9 @@ func (r *OrganizationResource) buildOrganizationRequest(ctx context.Context, dat
 		}
 	}
 
+	r.client.mergeDefaultMetadata(orgReq)
+	r.client.mergeDefaultTags(orgReq)
+
 	return orgReq
 }
 
@@ -408,7 +437,8 @@ func (r *OrganizationResource) readOrganization(ctx context.Context, data *Organ
 	}
 
 	// Handle tags list - preserve null when API returns empty and config didn't specify tags
-	if tags, ok := orgInfo["tags"].([]interface{}); ok && len(tags) > 0 {
+	apiTags, _ := orgInfo["tags"].([]interface{})
+	if tags := r.client.withoutDefaultTags(ctx, apiTags, data.Tags); len(tags) > 0 {
 		tagsList := make([]attr.Value, len(tags))
 		for i, t := range tags {
 			if str, ok := t.(string); ok {
@@ -421,7 +451,8 @@ func (r *OrganizationResource) readOrganization(ctx context.Context, data *Organ
 	}
 
 	// Handle metadata map - preserve null when API returns empty and config didn't specify metadata
-	if metadata, ok := orgInfo["metadata"].(map[string]interface{}); ok && len(metadata) > 0 {
+	apiMetadata, _ := orgInfo["metadata"].(map[string]interface{})
+	if metadata := r.client.withoutDefaultMetadata(ctx, apiMetadata, data.Metadata); len(metadata) > 0 {
 		metaMap := make(map[string]attr.Value)
 		for k, v := range metadata {
 			metaMap[k] = types.StringValue(metadataValueToString(v))
@@ -431,6 +462,9 @@ func (r *OrganizationResource) readOrganization(ctx context.Context, data *Organ
 		data.Metadata, _ = types.MapValue(types.StringType, map[string]attr.Value{})
 	}
 
+	data.MetadataAll = r.client.metadataAllFromAPI(ctx, data.Metadata, apiMetadata)
+	data.TagsAll = r.client.tagsAllFromAPI(ctx, data.Tags, apiTags)
+
 	// Handle model_rpm_limit map - preserve null when API returns empty and config didn't specify model_rpm_limit
 	if modelRPM, ok := orgInfo["model_rpm_limit"].(map[string]interface{}); ok && len(modelRPM) > 0 {
 		rpmMap := make(map[string]attr.Value)
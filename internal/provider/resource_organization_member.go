@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,12 +28,13 @@ type OrganizationMemberResource struct {
 }
 
 type OrganizationMemberResourceModel struct {
-	ID                      types.String  `tfsdk:"id"`
-	OrganizationID          types.String  `tfsdk:"organization_id"`
-	UserID                  types.String  `tfsdk:"user_id"`
-	UserEmail               types.String  `tfsdk:"user_email"`
-	Role                    types.String  `tfsdk:"role"`
-	MaxBudgetInOrganization types.Float64 `tfsdk:"max_budget_in_organization"`
+	ID                      types.String   `tfsdk:"id"`
+	OrganizationID          types.String   `tfsdk:"organization_id"`
+	UserID                  types.String   `tfsdk:"user_id"`
+	UserEmail               types.String   `tfsdk:"user_email"`
+	Role                    types.String   `tfsdk:"role"`
+	MaxBudgetInOrganization types.Float64  `tfsdk:"max_budget_in_organization"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *OrganizationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -91,6 +93,9 @@ func (r *OrganizationMemberResource) Schema(ctx context.Context, req resource.Sc
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -119,6 +124,9 @@ func (r *OrganizationMemberResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Validate that either user_id or user_email is provided
 	if data.UserID.IsNull() && data.UserEmail.IsNull() {
 		resp.Diagnostics.AddError(
@@ -151,7 +159,7 @@ func (r *OrganizationMemberResource) Create(ctx context.Context, req resource.Cr
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/organization/member_add", addReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add organization member: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to add organization member", err)
 		return
 	}
 
@@ -180,6 +188,9 @@ func (r *OrganizationMemberResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get organization info and check if user is a member
 	orgID := data.OrganizationID.ValueString()
 	endpoint := fmt.Sprintf("/organization/info?organization_id=%s", orgID)
@@ -190,7 +201,7 @@ func (r *OrganizationMemberResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read organization", err)
 		return
 	}
 
@@ -269,6 +280,9 @@ func (r *OrganizationMemberResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state OrganizationMemberResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -298,7 +312,7 @@ func (r *OrganizationMemberResource) Update(ctx context.Context, req resource.Up
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "PATCH", "/organization/member_update", updateReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization member: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update organization member", err)
 		return
 	}
 
@@ -313,14 +327,17 @@ func (r *OrganizationMemberResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"organization_id": data.OrganizationID.ValueString(),
 		"user_id":         data.UserID.ValueString(),
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", "/organization/member_delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove organization member: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to remove organization member", err)
 			return
 		}
 	}
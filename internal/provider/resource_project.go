@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +17,7 @@ import (
 
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -26,28 +28,31 @@ type ProjectResource struct {
 }
 
 type ProjectResourceModel struct {
-	ID                  types.String  `tfsdk:"id"`
-	ProjectAlias        types.String  `tfsdk:"project_alias"`
-	Description         types.String  `tfsdk:"description"`
-	TeamID              types.String  `tfsdk:"team_id"`
-	Models              types.List    `tfsdk:"models"`
-	Metadata            types.Map     `tfsdk:"metadata"`
-	Tags                types.List    `tfsdk:"tags"`
-	MaxBudget           types.Float64 `tfsdk:"max_budget"`
-	SoftBudget          types.Float64 `tfsdk:"soft_budget"`
-	BudgetDuration      types.String  `tfsdk:"budget_duration"`
-	BudgetID            types.String  `tfsdk:"budget_id"`
-	TPMLimit            types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit            types.Int64   `tfsdk:"rpm_limit"`
-	MaxParallelRequests types.Int64   `tfsdk:"max_parallel_requests"`
-	ModelMaxBudget      types.Map     `tfsdk:"model_max_budget"`
-	ModelRPMLimit       types.Map     `tfsdk:"model_rpm_limit"`
-	ModelTPMLimit       types.Map     `tfsdk:"model_tpm_limit"`
-	Blocked             types.Bool    `tfsdk:"blocked"`
-	CreatedAt           types.String  `tfsdk:"created_at"`
-	UpdatedAt           types.String  `tfsdk:"updated_at"`
-	CreatedBy           types.String  `tfsdk:"created_by"`
-	UpdatedBy           types.String  `tfsdk:"updated_by"`
+	ID                  types.String   `tfsdk:"id"`
+	ProjectAlias        types.String   `tfsdk:"project_alias"`
+	Description         types.String   `tfsdk:"description"`
+	TeamID              types.String   `tfsdk:"team_id"`
+	Models              types.List     `tfsdk:"models"`
+	Metadata            types.Map      `tfsdk:"metadata"`
+	Tags                types.List     `tfsdk:"tags"`
+	MetadataAll         types.Map      `tfsdk:"metadata_all"`
+	TagsAll             types.List     `tfsdk:"tags_all"`
+	MaxBudget           types.Float64  `tfsdk:"max_budget"`
+	SoftBudget          types.Float64  `tfsdk:"soft_budget"`
+	BudgetDuration      types.String   `tfsdk:"budget_duration"`
+	BudgetID            types.String   `tfsdk:"budget_id"`
+	TPMLimit            types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit            types.Int64    `tfsdk:"rpm_limit"`
+	MaxParallelRequests types.Int64    `tfsdk:"max_parallel_requests"`
+	ModelMaxBudget      types.Map      `tfsdk:"model_max_budget"`
+	ModelRPMLimit       types.Map      `tfsdk:"model_rpm_limit"`
+	ModelTPMLimit       types.Map      `tfsdk:"model_tpm_limit"`
+	Blocked             types.Bool     `tfsdk:"blocked"`
+	CreatedAt           types.String   `tfsdk:"created_at"`
+	UpdatedAt           types.String   `tfsdk:"updated_at"`
+	CreatedBy           types.String   `tfsdk:"created_by"`
+	UpdatedBy           types.String   `tfsdk:"updated_by"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -92,6 +97,8 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"metadata_all": metadataAllSchemaAttribute(),
+			"tags_all":     tagsAllSchemaAttribute(),
 			"tags": schema.ListAttribute{
 				Description: "Tags associated with the project.",
 				Optional:    true,
@@ -166,6 +173,9 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -182,6 +192,15 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	r.client.requireCapability(ctx, &resp.Diagnostics, capabilityProjects, "The litellm_project resource", path.Empty())
+	r.client.planDefaults(ctx, req, resp, "metadata", true)
+	r.client.planParentLimits(ctx, req, resp, "team", "team_id")
+}
+
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ProjectResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -189,11 +208,14 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	projectReq := r.buildProjectRequest(ctx, &data)
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/project/new", projectReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create project", err)
 		return
 	}
 
@@ -215,12 +237,15 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readProject(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read project", err)
 		return
 	}
 
@@ -234,6 +259,9 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state ProjectResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -245,7 +273,7 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	updateReq["project_id"] = data.ID.ValueString()
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/project/update", updateReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update project", err)
 		return
 	}
 
@@ -263,13 +291,18 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"project_ids": []string{data.ID.ValueString()},
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", "/project/delete", deleteReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project: %s", err))
-		return
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete project", err)
+			return
+		}
 	}
 }
 
@@ -378,6 +411,9 @@ func (r *ProjectResource) buildProjectRequest(ctx context.Context, data *Project
 		}
 	}
 
+	r.client.mergeDefaultMetadata(req)
+	r.client.mergeDefaultTags(req)
+
 	return req
 }
 
@@ -450,7 +486,8 @@ func (r *ProjectResource) readProject(ctx context.Context, data *ProjectResource
 	}
 
 	// Tags
-	if tags, ok := result["tags"].([]interface{}); ok && len(tags) > 0 {
+	apiTags, _ := result["tags"].([]interface{})
+	if tags := r.client.withoutDefaultTags(ctx, apiTags, data.Tags); len(tags) > 0 {
 		tagsList := make([]attr.Value, 0, len(tags))
 		for _, t := range tags {
 			if str, ok := t.(string); ok {
@@ -463,7 +500,8 @@ func (r *ProjectResource) readProject(ctx context.Context, data *ProjectResource
 	}
 
 	// Metadata
-	if metadata, ok := result["metadata"].(map[string]interface{}); ok && len(metadata) > 0 {
+	apiMetadata, _ := result["metadata"].(map[string]interface{})
+	if metadata := r.client.withoutDefaultMetadata(ctx, apiMetadata, data.Metadata); len(metadata) > 0 {
 		metaMap := make(map[string]attr.Value)
 		for k, v := range metadata {
 			metaMap[k] = types.StringValue(metadataValueToString(v))
@@ -473,6 +511,9 @@ func (r *ProjectResource) readProject(ctx context.Context, data *ProjectResource
 		data.Metadata, _ = types.MapValue(types.StringType, map[string]attr.Value{})
 	}
 
+	data.MetadataAll = r.client.metadataAllFromAPI(ctx, data.Metadata, apiMetadata)
+	data.TagsAll = r.client.tagsAllFromAPI(ctx, data.Tags, apiTags)
+
 	// Model max budget
 	if mmb, ok := result["model_max_budget"].(map[string]interface{}); ok && len(mmb) > 0 {
 		budgetMap := make(map[string]attr.Value)
@@ -4,8 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,16 +26,19 @@ type PromptResource struct {
 }
 
 type PromptResourceModel struct {
-	ID                                types.String `tfsdk:"id"`
-	PromptID                          types.String `tfsdk:"prompt_id"`
-	PromptIntegration                 types.String `tfsdk:"prompt_integration"`
-	APIBase                           types.String `tfsdk:"api_base"`
-	APIKey                            types.String `tfsdk:"api_key"`
-	ProviderSpecificQueryParams       types.String `tfsdk:"provider_specific_query_params"`
-	IgnorePromptManagerModel          types.Bool   `tfsdk:"ignore_prompt_manager_model"`
-	IgnorePromptManagerOptionalParams types.Bool   `tfsdk:"ignore_prompt_manager_optional_params"`
-	DotpromptContent                  types.String `tfsdk:"dotprompt_content"`
-	PromptType                        types.String `tfsdk:"prompt_type"`
+	ID                                types.String   `tfsdk:"id"`
+	PromptID                          types.String   `tfsdk:"prompt_id"`
+	PromptIntegration                 types.String   `tfsdk:"prompt_integration"`
+	APIBase                           types.String   `tfsdk:"api_base"`
+	APIKey                            types.String   `tfsdk:"api_key"`
+	APIKeyWO                          types.String   `tfsdk:"api_key_wo"`
+	APIKeyWOVersion                   types.Int64    `tfsdk:"api_key_wo_version"`
+	ProviderSpecificQueryParams       types.String   `tfsdk:"provider_specific_query_params"`
+	IgnorePromptManagerModel          types.Bool     `tfsdk:"ignore_prompt_manager_model"`
+	IgnorePromptManagerOptionalParams types.Bool     `tfsdk:"ignore_prompt_manager_optional_params"`
+	DotpromptContent                  types.String   `tfsdk:"dotprompt_content"`
+	PromptType                        types.String   `tfsdk:"prompt_type"`
+	Timeouts                          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *PromptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -73,6 +76,8 @@ func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_key_wo":         writeOnlyStringAttribute("API key for the prompt provider.", "api_key"),
+			"api_key_wo_version": writeOnlyVersionAttribute("api_key_wo"),
 			"provider_specific_query_params": schema.StringAttribute{
 				Description: "JSON string of provider-specific query parameters.",
 				Optional:    true,
@@ -94,6 +99,9 @@ func (r *PromptResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -122,18 +130,28 @@ func (r *PromptResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// Write-only values are only available from the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &data.APIKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	promptReq := r.buildPromptRequest(ctx, &data)
+	data.APIKeyWO = types.StringNull()
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/prompts", promptReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create prompt: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create prompt", err)
 		return
 	}
 
 	data.ID = data.PromptID
 
 	// Read back for full state
-	if err := r.readPromptWithRetry(ctx, &data, 8); err != nil {
+	if err := r.readPromptWithRetry(ctx, &data, readBackAttempts(ctx, 8)); err != nil {
 		resp.Diagnostics.AddWarning("Read Error", fmt.Sprintf("Prompt created but failed to read back: %s", err))
 	}
 
@@ -148,12 +166,15 @@ func (r *PromptResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readPromptWithRetry(ctx, &data, 8); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read prompt: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read prompt", err)
 		return
 	}
 
@@ -168,6 +189,9 @@ func (r *PromptResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state PromptResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -178,11 +202,18 @@ func (r *PromptResource) Update(ctx context.Context, req resource.UpdateRequest,
 	data.ID = state.ID
 	data.PromptID = state.PromptID
 
+	// Write-only values are only available from the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &data.APIKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	promptReq := r.buildPromptRequest(ctx, &data)
+	data.APIKeyWO = types.StringNull()
 
 	endpoint := fmt.Sprintf("/prompts/%s", data.PromptID.ValueString())
 	if err := r.client.DoRequestWithResponse(ctx, "PUT", endpoint, promptReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update prompt: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update prompt", err)
 		return
 	}
 
@@ -202,10 +233,13 @@ func (r *PromptResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	endpoint := fmt.Sprintf("/prompts/%s", data.PromptID.ValueString())
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete prompt: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete prompt", err)
 			return
 		}
 	}
@@ -225,8 +259,8 @@ func (r *PromptResource) buildPromptRequest(ctx context.Context, data *PromptRes
 	if !data.APIBase.IsNull() && !data.APIBase.IsUnknown() && data.APIBase.ValueString() != "" {
 		litellmParams["api_base"] = data.APIBase.ValueString()
 	}
-	if !data.APIKey.IsNull() && !data.APIKey.IsUnknown() && data.APIKey.ValueString() != "" {
-		litellmParams["api_key"] = data.APIKey.ValueString()
+	if v, ok := secretValue(data.APIKey, data.APIKeyWO); ok {
+		litellmParams["api_key"] = v
 	}
 	if !data.DotpromptContent.IsNull() && !data.DotpromptContent.IsUnknown() && data.DotpromptContent.ValueString() != "" {
 		litellmParams["dotprompt_content"] = data.DotpromptContent.ValueString()
@@ -261,30 +295,9 @@ func (r *PromptResource) buildPromptRequest(ctx context.Context, data *PromptRes
 }
 
 func (r *PromptResource) readPromptWithRetry(ctx context.Context, data *PromptResourceModel, maxRetries int) error {
-	var err error
-	delay := 1 * time.Second
-	maxDelay := 10 * time.Second
-
-	for i := 0; i < maxRetries; i++ {
-		err = r.readPrompt(ctx, data)
-		if err == nil {
-			return nil
-		}
-
-		if !IsNotFoundError(err) {
-			return err
-		}
-
-		if i < maxRetries-1 {
-			time.Sleep(delay)
-			delay *= 2
-			if delay > maxDelay {
-				delay = maxDelay
-			}
-		}
-	}
-
-	return err
+	return r.client.retryWhile(ctx, maxRetries, IsNotFoundError, func() error {
+		return r.readPrompt(ctx, data)
+	})
 }
 
 func (r *PromptResource) readPrompt(ctx context.Context, data *PromptResourceModel) error {
@@ -293,7 +306,7 @@ func (r *PromptResource) readPrompt(ctx context.Context, data *PromptResourceMod
 		promptID = data.ID.ValueString()
 	}
 
-	endpoint := fmt.Sprintf("/prompts/%s", promptID)
+	endpoint := promptInfoEndpoint(ctx, r.client, promptID)
 
 	var rawResult map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "GET", endpoint, nil, &rawResult); err != nil {
@@ -341,6 +354,18 @@ func (r *PromptResource) readPrompt(ctx context.Context, data *PromptResourceMod
 	return nil
 }
 
+// promptInfoEndpoint returns the route that reads a single prompt on the
+// connected server. Releases before GET /prompts/{prompt_id} only serve
+// /prompts/{prompt_id}/info.
+func promptInfoEndpoint(ctx context.Context, c *Client, promptID string) string {
+	if c.supports(ctx, capabilityPromptByID) {
+		return fmt.Sprintf("/prompts/%s", promptID)
+	}
+	return fmt.Sprintf("/prompts/%s/info", promptID)
+}
+
+// parsePromptResult unwraps the prompt_spec envelope returned by the
+// /prompts/{prompt_id}/info route and some releases of /prompts/{prompt_id}.
 func parsePromptResult(rawResult map[string]interface{}) map[string]interface{} {
 	if promptSpec, ok := rawResult["prompt_spec"].(map[string]interface{}); ok {
 		return promptSpec
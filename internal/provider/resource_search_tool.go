@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,15 +26,18 @@ type SearchToolResource struct {
 }
 
 type SearchToolResourceModel struct {
-	ID             types.String  `tfsdk:"id"`
-	SearchToolID   types.String  `tfsdk:"search_tool_id"`
-	SearchToolName types.String  `tfsdk:"search_tool_name"`
-	SearchProvider types.String  `tfsdk:"search_provider"`
-	APIKey         types.String  `tfsdk:"api_key"`
-	APIBase        types.String  `tfsdk:"api_base"`
-	Timeout        types.Float64 `tfsdk:"timeout"`
-	MaxRetries     types.Int64   `tfsdk:"max_retries"`
-	SearchToolInfo types.String  `tfsdk:"search_tool_info"`
+	ID              types.String   `tfsdk:"id"`
+	SearchToolID    types.String   `tfsdk:"search_tool_id"`
+	SearchToolName  types.String   `tfsdk:"search_tool_name"`
+	SearchProvider  types.String   `tfsdk:"search_provider"`
+	APIKey          types.String   `tfsdk:"api_key"`
+	APIKeyWO        types.String   `tfsdk:"api_key_wo"`
+	APIKeyWOVersion types.Int64    `tfsdk:"api_key_wo_version"`
+	APIBase         types.String   `tfsdk:"api_base"`
+	Timeout         types.Float64  `tfsdk:"timeout"`
+	MaxRetries      types.Int64    `tfsdk:"max_retries"`
+	SearchToolInfo  types.String   `tfsdk:"search_tool_info"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *SearchToolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,6 +75,8 @@ func (r *SearchToolResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_key_wo":         writeOnlyStringAttribute("API key for the search provider.", "api_key"),
+			"api_key_wo_version": writeOnlyVersionAttribute("api_key_wo"),
 			"api_base": schema.StringAttribute{
 				Description: "Base URL for the search API.",
 				Optional:    true,
@@ -88,6 +94,9 @@ func (r *SearchToolResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -116,7 +125,17 @@ func (r *SearchToolResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// Write-only values are only available from the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &data.APIKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	searchToolBody := r.buildSearchToolRequest(ctx, &data)
+	data.APIKeyWO = types.StringNull()
 	// API expects {"search_tool": {...}}
 	searchReq := map[string]interface{}{
 		"search_tool": searchToolBody,
@@ -124,7 +143,7 @@ func (r *SearchToolResource) Create(ctx context.Context, req resource.CreateRequ
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/search_tools", searchReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create search tool: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create search tool", err)
 		return
 	}
 
@@ -154,12 +173,15 @@ func (r *SearchToolResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readSearchTool(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read search tool: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read search tool", err)
 		return
 	}
 
@@ -174,6 +196,9 @@ func (r *SearchToolResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state SearchToolResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -184,7 +209,14 @@ func (r *SearchToolResource) Update(ctx context.Context, req resource.UpdateRequ
 	data.ID = state.ID
 	data.SearchToolID = state.SearchToolID
 
+	// Write-only values are only available from the configuration.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &data.APIKeyWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	searchToolBody := r.buildSearchToolRequest(ctx, &data)
+	data.APIKeyWO = types.StringNull()
 	searchToolBody["search_tool_id"] = data.SearchToolID.ValueString()
 	// API expects {"search_tool": {...}}
 	searchReq := map[string]interface{}{
@@ -193,7 +225,7 @@ func (r *SearchToolResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	endpoint := fmt.Sprintf("/search_tools/%s", data.SearchToolID.ValueString())
 	if err := r.client.DoRequestWithResponse(ctx, "PUT", endpoint, searchReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update search tool: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update search tool", err)
 		return
 	}
 
@@ -213,6 +245,9 @@ func (r *SearchToolResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	searchToolID := data.SearchToolID.ValueString()
 	if searchToolID == "" {
 		searchToolID = data.ID.ValueString()
@@ -220,8 +255,8 @@ func (r *SearchToolResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	endpoint := fmt.Sprintf("/search_tools/%s", searchToolID)
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete search tool: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete search tool", err)
 			return
 		}
 	}
@@ -243,8 +278,8 @@ func (r *SearchToolResource) buildSearchToolRequest(ctx context.Context, data *S
 	}
 
 	// String fields - check IsNull, IsUnknown, and empty string
-	if !data.APIKey.IsNull() && !data.APIKey.IsUnknown() && data.APIKey.ValueString() != "" {
-		litellmParams["api_key"] = data.APIKey.ValueString()
+	if v, ok := secretValue(data.APIKey, data.APIKeyWO); ok {
+		litellmParams["api_key"] = v
 	}
 
 	if !data.APIBase.IsNull() && !data.APIBase.IsUnknown() && data.APIBase.ValueString() != "" {
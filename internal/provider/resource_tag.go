@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,18 +27,19 @@ type TagResource struct {
 }
 
 type TagResourceModel struct {
-	ID                  types.String  `tfsdk:"id"`
-	Name                types.String  `tfsdk:"name"`
-	Description         types.String  `tfsdk:"description"`
-	Models              types.List    `tfsdk:"models"`
-	BudgetID            types.String  `tfsdk:"budget_id"`
-	MaxBudget           types.Float64 `tfsdk:"max_budget"`
-	SoftBudget          types.Float64 `tfsdk:"soft_budget"`
-	MaxParallelRequests types.Int64   `tfsdk:"max_parallel_requests"`
-	TPMLimit            types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit            types.Int64   `tfsdk:"rpm_limit"`
-	BudgetDuration      types.String  `tfsdk:"budget_duration"`
-	ModelMaxBudget      types.String  `tfsdk:"model_max_budget"`
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	Models              types.List     `tfsdk:"models"`
+	BudgetID            types.String   `tfsdk:"budget_id"`
+	MaxBudget           types.Float64  `tfsdk:"max_budget"`
+	SoftBudget          types.Float64  `tfsdk:"soft_budget"`
+	MaxParallelRequests types.Int64    `tfsdk:"max_parallel_requests"`
+	TPMLimit            types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit            types.Int64    `tfsdk:"rpm_limit"`
+	BudgetDuration      types.String   `tfsdk:"budget_duration"`
+	ModelMaxBudget      types.String   `tfsdk:"model_max_budget"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -105,6 +107,9 @@ func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -133,11 +138,14 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	tagReq := r.buildTagRequest(ctx, &data)
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/tag/new", tagReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tag: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create tag", err)
 		return
 	}
 
@@ -160,12 +168,15 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readTag(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tag: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read tag", err)
 		return
 	}
 
@@ -180,6 +191,9 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state TagResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -193,7 +207,7 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	tagReq := r.buildTagRequest(ctx, &data)
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/tag/update", tagReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tag: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update tag", err)
 		return
 	}
 
@@ -213,13 +227,16 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"name": data.Name.ValueString(),
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/tag/delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete tag", err)
 			return
 		}
 	}
@@ -311,7 +328,7 @@ func (r *TagResource) readTag(ctx context.Context, data *TagResourceModel) error
 	}
 
 	if result == nil || len(result) == 0 {
-		return fmt.Errorf("tag not found: %s", tagName)
+		return fmt.Errorf("tag %s: %w", tagName, errNotFound)
 	}
 
 	// Update fields from response
@@ -5,18 +5,26 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var _ resource.Resource = &TeamResource{}
 var _ resource.ResourceWithImportState = &TeamResource{}
+var _ resource.ResourceWithIdentity = &TeamResource{}
+var _ resource.ResourceWithModifyPlan = &TeamResource{}
 
 func NewTeamResource() resource.Resource {
 	return &TeamResource{}
@@ -27,29 +35,77 @@ type TeamResource struct {
 }
 
 type TeamResourceModel struct {
-	ID                    types.String  `tfsdk:"id"`
-	TeamAlias             types.String  `tfsdk:"team_alias"`
-	OrganizationID        types.String  `tfsdk:"organization_id"`
-	Metadata              types.Map     `tfsdk:"metadata"`
-	TPMLimit              types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit              types.Int64   `tfsdk:"rpm_limit"`
-	TPMLimitType          types.String  `tfsdk:"tpm_limit_type"`
-	RPMLimitType          types.String  `tfsdk:"rpm_limit_type"`
-	MaxBudget             types.Float64 `tfsdk:"max_budget"`
-	BudgetDuration        types.String  `tfsdk:"budget_duration"`
-	Models                types.List    `tfsdk:"models"`
-	ModelAliases          types.Map     `tfsdk:"model_aliases"`
-	ModelRPMLimit         types.Map     `tfsdk:"model_rpm_limit"`
-	ModelTPMLimit         types.Map     `tfsdk:"model_tpm_limit"`
-	Tags                  types.List    `tfsdk:"tags"`
-	Guardrails            types.List    `tfsdk:"guardrails"`
-	Prompts               types.List    `tfsdk:"prompts"`
-	Blocked               types.Bool    `tfsdk:"blocked"`
-	TeamMemberPermissions types.List    `tfsdk:"team_member_permissions"`
-	TeamMemberBudget      types.Float64 `tfsdk:"team_member_budget"`
-	TeamMemberRPMLimit    types.Int64   `tfsdk:"team_member_rpm_limit"`
-	TeamMemberTPMLimit    types.Int64   `tfsdk:"team_member_tpm_limit"`
-	RouterSettings        types.Object  `tfsdk:"router_settings"`
+	ID                    types.String   `tfsdk:"id"`
+	TeamAlias             types.String   `tfsdk:"team_alias"`
+	OrganizationID        types.String   `tfsdk:"organization_id"`
+	Metadata              types.Map      `tfsdk:"metadata"`
+	TPMLimit              types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit              types.Int64    `tfsdk:"rpm_limit"`
+	TPMLimitType          types.String   `tfsdk:"tpm_limit_type"`
+	RPMLimitType          types.String   `tfsdk:"rpm_limit_type"`
+	MaxBudget             types.Float64  `tfsdk:"max_budget"`
+	BudgetDuration        types.String   `tfsdk:"budget_duration"`
+	Models                types.List     `tfsdk:"models"`
+	ModelAliases          types.Map      `tfsdk:"model_aliases"`
+	ModelRPMLimit         types.Map      `tfsdk:"model_rpm_limit"`
+	ModelTPMLimit         types.Map      `tfsdk:"model_tpm_limit"`
+	Tags                  types.List     `tfsdk:"tags"`
+	MetadataAll           types.Map      `tfsdk:"metadata_all"`
+	TagsAll               types.List     `tfsdk:"tags_all"`
+	Guardrails            types.List     `tfsdk:"guardrails"`
+	Prompts               types.List     `tfsdk:"prompts"`
+	Blocked               types.Bool     `tfsdk:"blocked"`
+	TeamMemberPermissions types.List     `tfsdk:"team_member_permissions"`
+	TeamMemberBudget      types.Float64  `tfsdk:"team_member_budget"`
+	TeamMemberRPMLimit    types.Int64    `tfsdk:"team_member_rpm_limit"`
+	TeamMemberTPMLimit    types.Int64    `tfsdk:"team_member_tpm_limit"`
+	RouterSettings        types.Object   `tfsdk:"router_settings"`
+	BudgetLimits          types.List     `tfsdk:"budget_limits"`
+	SecretManagerSettings types.Map      `tfsdk:"secret_manager_settings"`
+	DefaultMemberModels   types.List     `tfsdk:"default_team_member_models"`
+	MemberKeyDuration     types.String   `tfsdk:"team_member_key_duration"`
+	MemberBudgetDuration  types.String   `tfsdk:"team_member_budget_duration"`
+	FileExpiresAfter      types.Object   `tfsdk:"enforced_file_expires_after"`
+	BatchExpiresAfter     types.Object   `tfsdk:"enforced_batch_output_expires_after"`
+	ObjectPermission      types.Object   `tfsdk:"object_permission"`
+	Policies              types.List     `tfsdk:"policies"`
+	AccessGroupIDs        types.List     `tfsdk:"access_group_ids"`
+	MembersWithRoles      types.List     `tfsdk:"members_with_roles"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ExpiresAfterModel is an expiry LiteLLM enforces on files or batch outputs
+// created with a team's keys, in the OpenAI expires_after format.
+type ExpiresAfterModel struct {
+	Anchor  types.String `tfsdk:"anchor"`
+	Seconds types.Int64  `tfsdk:"seconds"`
+}
+
+var teamMemberWithRoleAttrTypes = map[string]attr.Type{
+	"user_id":    types.StringType,
+	"user_email": types.StringType,
+	"role":       types.StringType,
+}
+
+var expiresAfterAttrTypes = map[string]attr.Type{
+	"anchor":  types.StringType,
+	"seconds": types.Int64Type,
+}
+
+// teamSettingsMetadataKeys are team settings that LiteLLM may keep in the
+// team's metadata. They have their own attributes, or in the case of
+// callback_settings are managed by litellm_team_callback, so they are left
+// out of metadata and metadata_all when read back.
+var teamSettingsMetadataKeys = []string{
+	"secret_manager_settings",
+	"default_team_member_models",
+	"team_member_key_duration",
+	"team_member_budget_duration",
+	"enforced_file_expires_after",
+	"enforced_batch_output_expires_after",
+	"policies",
+	"access_group_ids",
+	"callback_settings",
 }
 
 type RouterSettingsModel struct {
@@ -72,6 +128,73 @@ var routerSettingsAttrTypes = map[string]attr.Type{
 	"context_window_fallbacks": types.ListType{ElemType: types.ObjectType{AttrTypes: fallbackEntryAttrTypes}},
 }
 
+// routerSettingsSchemaAttribute returns the router_settings attribute shared
+// by keys and teams.
+func routerSettingsSchemaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"fallbacks":                fallbackEntriesSchemaAttribute("Fallback model chains triggered when a model call fails after retries."),
+			"context_window_fallbacks": fallbackEntriesSchemaAttribute("Fallback model chains triggered when a context window exceeded error occurs."),
+		},
+	}
+}
+
+func fallbackEntriesSchemaAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"model": schema.StringAttribute{
+					Description: "The primary model name to configure fallbacks for.",
+					Required:    true,
+				},
+				"fallback_models": schema.ListAttribute{
+					Description: "Ordered list of fallback model names.",
+					Required:    true,
+					ElementType: types.StringType,
+				},
+			},
+		},
+	}
+}
+
+// expiresAfterSchemaAttribute returns an enforced_*_expires_after attribute.
+func expiresAfterSchemaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"anchor": schema.StringAttribute{
+				Description: "Timestamp the expiry is counted from. Only created_at is supported.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("created_at"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("created_at"),
+				},
+			},
+			"seconds": schema.Int64Attribute{
+				Description: "Seconds after the anchor at which the object expires, between 3600 (1 hour) and 2592000 (30 days).",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(3600, 2592000),
+				},
+			},
+		},
+	}
+}
+
+type TeamIdentityModel struct {
+	TeamID types.String `tfsdk:"team_id"`
+}
+
+func teamIdentity(data *TeamResourceModel) TeamIdentityModel {
+	return TeamIdentityModel{TeamID: data.ID}
+}
+
 func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team"
 }
@@ -110,12 +233,18 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:    true,
 			},
 			"tpm_limit_type": schema.StringAttribute{
-				Description: "Type of TPM limit: 'key' or 'team'. If 'team', TPM is shared across all keys for the team.",
+				Description: "How the TPM limit is enforced: guaranteed_throughput, best_effort_throughput or dynamic.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(limitTypeValues...),
+				},
 			},
 			"rpm_limit_type": schema.StringAttribute{
-				Description: "Type of RPM limit: 'key' or 'team'. If 'team', RPM is shared across all keys for the team.",
+				Description: "How the RPM limit is enforced: guaranteed_throughput, best_effort_throughput or dynamic.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(limitTypeValues...),
+				},
 			},
 			"max_budget": schema.Float64Attribute{
 				Description: "Maximum budget for the team.",
@@ -124,7 +253,11 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			"budget_duration": schema.StringAttribute{
 				Description: "Budget reset duration.",
 				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
 			},
+			"budget_limits": budgetLimitsSchemaAttribute("team"),
 			"models": schema.ListAttribute{
 				Description: "List of models the team can access.",
 				Optional:    true,
@@ -149,6 +282,8 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:    true,
 				ElementType: types.Int64Type,
 			},
+			"metadata_all": metadataAllSchemaAttribute(),
+			"tags_all":     tagsAllSchemaAttribute(),
 			"tags": schema.ListAttribute{
 				Description: "Tags for the team (for spend tracking and routing).",
 				Optional:    true,
@@ -190,49 +325,83 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "Default TPM limit for team members.",
 				Optional:    true,
 			},
-			"router_settings": schema.SingleNestedAttribute{
-				Description: "Router settings for the team, including fallback configurations. " +
-					"These override global fallback settings for requests made with this team's keys. " +
-					"Resolution order: Key > Team > Global.",
-				Optional: true,
-				Attributes: map[string]schema.Attribute{
-					"fallbacks": schema.ListNestedAttribute{
-						Description: "Fallback model chains triggered when a model call fails after retries.",
-						Optional:    true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"model": schema.StringAttribute{
-									Description: "The primary model name to configure fallbacks for.",
-									Required:    true,
-								},
-								"fallback_models": schema.ListAttribute{
-									Description: "Ordered list of fallback model names.",
-									Required:    true,
-									ElementType: types.StringType,
-								},
-							},
+			"router_settings": routerSettingsSchemaAttribute("Router settings for the team, including fallback configurations. " +
+				"These override global fallback settings for requests made with this team's keys. " +
+				"Resolution order: Key > Team > Global."),
+			"secret_manager_settings": schema.MapAttribute{
+				Description: "Secret manager settings for keys created by the team, such as where their secrets are written. " +
+					"Values are strings; use jsonencode() for objects and arrays.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"default_team_member_models": schema.ListAttribute{
+				Description: "Models given to members added to the team when no models are set for them.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"team_member_key_duration": schema.StringAttribute{
+				Description: "Default duration of keys created by team members (e.g., '30d').",
+				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
+			},
+			"team_member_budget_duration": schema.StringAttribute{
+				Description: "Reset period of team_member_budget (e.g., '1mo').",
+				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
+			},
+			"enforced_file_expires_after":         expiresAfterSchemaAttribute("Expiry enforced on files uploaded with the team's keys."),
+			"enforced_batch_output_expires_after": expiresAfterSchemaAttribute("Expiry enforced on batch output files created with the team's keys."),
+			"object_permission":                   objectPermissionSchemaAttribute("MCP servers, tools, vector stores and agents the team can use."),
+			"policies": schema.ListAttribute{
+				Description: "Names of the policies applied to the team.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"access_group_ids": schema.ListAttribute{
+				Description: "Access group IDs whose models and resources the team can use.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"members_with_roles": schema.ListNestedAttribute{
+				Description: "Members of the team and their roles, as reported by LiteLLM. Read-only; manage membership with litellm_team_member or litellm_team_member_add.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "User ID of the member.",
+							Computed:    true,
 						},
-					},
-					"context_window_fallbacks": schema.ListNestedAttribute{
-						Description: "Fallback model chains triggered when a context window exceeded error occurs.",
-						Optional:    true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"model": schema.StringAttribute{
-									Description: "The primary model name to configure fallbacks for.",
-									Required:    true,
-								},
-								"fallback_models": schema.ListAttribute{
-									Description: "Ordered list of fallback model names.",
-									Required:    true,
-									ElementType: types.StringType,
-								},
-							},
+						"user_email": schema.StringAttribute{
+							Description: "Email of the member.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the member in the team (admin or user).",
+							Computed:    true,
 						},
 					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *TeamResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"team_id": identityschema.StringAttribute{
+				Description:       "The team ID.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
 
@@ -253,6 +422,11 @@ func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+func (r *TeamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.planDefaults(ctx, req, resp, "metadata", true)
+	r.client.planParentLimits(ctx, req, resp, "organization", "organization_id")
+}
+
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TeamResourceModel
 
@@ -261,11 +435,14 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	teamID := uuid.New().String()
 	teamReq := r.buildTeamRequest(ctx, &data, teamID)
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/new", teamReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create team", err)
 		return
 	}
 
@@ -277,6 +454,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, teamIdentity(&data))
 }
 
 func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -287,16 +465,20 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readTeam(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read team", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, teamIdentity(&data))
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -307,6 +489,9 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state TeamResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -317,8 +502,14 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	teamReq := r.buildTeamRequest(ctx, &data, data.ID.ValueString())
 	applyTeamNullableClears(teamReq, &state, &data)
 
-	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/update", teamReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team: %s", err))
+	unlock := lockTeamMetadata(data.ID.ValueString())
+	err := r.client.keepTeamCallbackSettings(ctx, data.ID.ValueString(), teamReq)
+	if err == nil {
+		err = r.client.DoRequestWithResponse(ctx, "POST", "/team/update", teamReq, nil)
+	}
+	unlock()
+	if err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update team", err)
 		return
 	}
 
@@ -340,6 +531,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, teamIdentity(&data))
 }
 
 func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -350,20 +542,62 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"team_ids": []string{data.ID.ValueString()},
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete team", err)
 			return
 		}
 	}
 }
 
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID is the team ID or its team_alias.
+	teamID := importID(ctx, req, "team_id", &resp.Diagnostics)
+	if req.ID != "" {
+		resolved, err := r.resolveImportTeamID(ctx, req.ID)
+		if err != nil {
+			addImportError(ctx, &resp.Diagnostics, req.ID, err)
+			return
+		}
+		teamID = resolved
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamID)...)
+}
+
+// resolveImportTeamID returns the ID of the team whose ID or team_alias is id.
+// An ID that matches no listed team is returned unchanged, so teams the list
+// endpoint does not show can still be imported by ID. So is any ID when the
+// teams cannot be listed.
+func (r *TeamResource) resolveImportTeamID(ctx context.Context, id string) (string, error) {
+	teams, err := (&TeamListResource{client: r.client}).listTeams(ctx, TeamListResourceModel{
+		OrganizationID: types.StringNull(),
+		UserID:         types.StringNull(),
+	})
+	if err != nil {
+		return importIDAfterListError(ctx, "team", id, err)
+	}
+
+	var matches []string
+	for _, team := range teams {
+		teamID, _ := team["team_id"].(string)
+		if teamID == id {
+			return teamID, nil
+		}
+		if alias, _ := team["team_alias"].(string); alias == id && teamID != "" {
+			matches = append(matches, teamID)
+		}
+	}
+	if len(matches) == 0 {
+		return id, nil
+	}
+	return uniqueImportMatch("team", "team_alias", id, matches)
 }
 
 func (r *TeamResource) buildTeamRequest(ctx context.Context, data *TeamResourceModel, teamID string) map[string]interface{} {
@@ -491,6 +725,43 @@ func (r *TeamResource) buildTeamRequest(ctx context.Context, data *TeamResourceM
 		teamReq["router_settings"] = map[string]interface{}{}
 	}
 
+	if !data.BudgetLimits.IsNull() && !data.BudgetLimits.IsUnknown() {
+		teamReq["budget_limits"] = buildBudgetLimitsPayload(ctx, data.BudgetLimits)
+	}
+
+	if !data.SecretManagerSettings.IsNull() && !data.SecretManagerSettings.IsUnknown() {
+		var settings map[string]string
+		data.SecretManagerSettings.ElementsAs(ctx, &settings, false)
+		teamReq["secret_manager_settings"] = convertMetadataToNative(settings)
+	}
+	if !data.DefaultMemberModels.IsNull() && !data.DefaultMemberModels.IsUnknown() {
+		teamReq["default_team_member_models"] = listToStringSlice(data.DefaultMemberModels)
+	}
+	if !data.MemberKeyDuration.IsNull() && !data.MemberKeyDuration.IsUnknown() {
+		teamReq["team_member_key_duration"] = data.MemberKeyDuration.ValueString()
+	}
+	if !data.MemberBudgetDuration.IsNull() && !data.MemberBudgetDuration.IsUnknown() {
+		teamReq["team_member_budget_duration"] = data.MemberBudgetDuration.ValueString()
+	}
+	if !data.FileExpiresAfter.IsNull() && !data.FileExpiresAfter.IsUnknown() {
+		teamReq["enforced_file_expires_after"] = buildExpiresAfterPayload(ctx, data.FileExpiresAfter)
+	}
+	if !data.BatchExpiresAfter.IsNull() && !data.BatchExpiresAfter.IsUnknown() {
+		teamReq["enforced_batch_output_expires_after"] = buildExpiresAfterPayload(ctx, data.BatchExpiresAfter)
+	}
+	if !data.ObjectPermission.IsNull() && !data.ObjectPermission.IsUnknown() {
+		teamReq["object_permission"] = buildObjectPermissionPayload(ctx, data.ObjectPermission)
+	}
+	if !data.Policies.IsNull() && !data.Policies.IsUnknown() {
+		teamReq["policies"] = listToStringSlice(data.Policies)
+	}
+	if !data.AccessGroupIDs.IsNull() && !data.AccessGroupIDs.IsUnknown() {
+		teamReq["access_group_ids"] = listToStringSlice(data.AccessGroupIDs)
+	}
+
+	r.client.mergeDefaultMetadata(teamReq)
+	r.client.mergeDefaultTags(teamReq)
+
 	return teamReq
 }
 
@@ -506,6 +777,9 @@ func applyTeamNullableClears(teamReq map[string]interface{}, state, plan *TeamRe
 	if !state.BudgetDuration.IsNull() && plan.BudgetDuration.IsNull() {
 		teamReq["budget_duration"] = nil
 	}
+	if !state.BudgetLimits.IsNull() && plan.BudgetLimits.IsNull() {
+		teamReq["budget_limits"] = nil
+	}
 	if !state.TPMLimit.IsNull() && plan.TPMLimit.IsNull() {
 		teamReq["tpm_limit"] = nil
 	}
@@ -521,6 +795,42 @@ func applyTeamNullableClears(teamReq map[string]interface{}, state, plan *TeamRe
 	if !state.TeamMemberTPMLimit.IsNull() && plan.TeamMemberTPMLimit.IsNull() {
 		teamReq["team_member_tpm_limit"] = nil
 	}
+	if !state.SecretManagerSettings.IsNull() && plan.SecretManagerSettings.IsNull() {
+		teamReq["secret_manager_settings"] = nil
+	}
+	if !state.DefaultMemberModels.IsNull() && plan.DefaultMemberModels.IsNull() {
+		teamReq["default_team_member_models"] = []string{}
+	}
+	if !state.MemberKeyDuration.IsNull() && plan.MemberKeyDuration.IsNull() {
+		teamReq["team_member_key_duration"] = nil
+	}
+	if !state.MemberBudgetDuration.IsNull() && plan.MemberBudgetDuration.IsNull() {
+		teamReq["team_member_budget_duration"] = nil
+	}
+	if !state.FileExpiresAfter.IsNull() && plan.FileExpiresAfter.IsNull() {
+		teamReq["enforced_file_expires_after"] = nil
+	}
+	if !state.BatchExpiresAfter.IsNull() && plan.BatchExpiresAfter.IsNull() {
+		teamReq["enforced_batch_output_expires_after"] = nil
+	}
+	applyObjectPermissionClears(teamReq, state.ObjectPermission, plan.ObjectPermission)
+	if !state.Policies.IsNull() && plan.Policies.IsNull() {
+		teamReq["policies"] = []string{}
+	}
+	if !state.AccessGroupIDs.IsNull() && plan.AccessGroupIDs.IsNull() {
+		teamReq["access_group_ids"] = []string{}
+	}
+}
+
+// buildExpiresAfterPayload converts an enforced_*_expires_after object into
+// the API format.
+func buildExpiresAfterPayload(ctx context.Context, obj types.Object) map[string]interface{} {
+	var expires ExpiresAfterModel
+	obj.As(ctx, &expires, basetypes.ObjectAsOptions{})
+	return map[string]interface{}{
+		"anchor":  expires.Anchor.ValueString(),
+		"seconds": expires.Seconds.ValueInt64(),
+	}
 }
 
 // buildRouterSettingsPayload converts the Terraform router_settings object into
@@ -616,6 +926,7 @@ func (r *TeamResource) readTeam(ctx context.Context, data *TeamResourceModel) er
 	} else if data.BudgetDuration.IsUnknown() {
 		data.BudgetDuration = types.StringNull()
 	}
+	data.BudgetLimits = parseBudgetLimitsFromAPI(teamInfo["budget_limits"], data.BudgetLimits)
 	if blocked, ok := teamInfo["blocked"].(bool); ok {
 		data.Blocked = types.BoolValue(blocked)
 	}
@@ -667,7 +978,8 @@ func (r *TeamResource) readTeam(ctx context.Context, data *TeamResourceModel) er
 	}
 
 	// Handle tags list - preserve null when API returns empty and config didn't specify tags
-	if tags, ok := teamInfo["tags"].([]interface{}); ok && len(tags) > 0 {
+	apiTags, _ := teamInfo["tags"].([]interface{})
+	if tags := r.client.withoutDefaultTags(ctx, apiTags, data.Tags); len(tags) > 0 {
 		tagsList := make([]attr.Value, 0, len(tags))
 		for _, t := range tags {
 			if str, ok := t.(string); ok {
@@ -708,7 +1020,8 @@ func (r *TeamResource) readTeam(ctx context.Context, data *TeamResourceModel) er
 	// Handle metadata map - preserve null when API returns empty and config didn't specify metadata.
 	// The API may inject internal keys (e.g. tpm_limit_type, rpm_limit_type) into metadata.
 	// Only include keys that were in the user's original config to avoid drift.
-	if metadata, ok := teamInfo["metadata"].(map[string]interface{}); ok && len(metadata) > 0 {
+	apiMetadata := withoutTeamSettings(teamInfo["metadata"])
+	if metadata := r.client.withoutDefaultMetadata(ctx, apiMetadata, data.Metadata); len(metadata) > 0 {
 		configuredKeys := make(map[string]bool)
 		if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
 			var currentMeta map[string]string
@@ -734,6 +1047,9 @@ func (r *TeamResource) readTeam(ctx context.Context, data *TeamResourceModel) er
 		data.Metadata, _ = types.MapValue(types.StringType, map[string]attr.Value{})
 	}
 
+	data.MetadataAll = r.client.metadataAllFromAPI(ctx, data.Metadata, apiMetadata)
+	data.TagsAll = r.client.tagsAllFromAPI(ctx, data.Tags, apiTags)
+
 	// Handle model_aliases map
 	// The API may not echo back model_aliases, so only clear on Unknown.
 	if modelAliases, ok := teamInfo["model_aliases"].(map[string]interface{}); ok && len(modelAliases) > 0 {
@@ -784,6 +1100,9 @@ func (r *TeamResource) readTeam(ctx context.Context, data *TeamResourceModel) er
 		data.RouterSettings = types.ObjectNull(routerSettingsAttrTypes)
 	}
 
+	r.readTeamSettings(ctx, teamInfo, data)
+	data.MembersWithRoles = parseTeamMembersWithRoles(teamInfo["members_with_roles"])
+
 	// Fetch permissions separately - preserve null when API returns empty and config didn't specify permissions
 	permEndpoint := fmt.Sprintf("/team/permissions_list?team_id=%s", data.ID.ValueString())
 	var permResult map[string]interface{}
@@ -806,6 +1125,149 @@ func (r *TeamResource) readTeam(ctx context.Context, data *TeamResourceModel) er
 	return nil
 }
 
+// readTeamSettings reads secret_manager_settings, the team member defaults,
+// the enforced expiries, object_permission, policies and access_group_ids.
+// Depending on the LiteLLM version, some of them are kept in metadata rather
+// than at the top level, so both are checked. Like budget_duration, a setting
+// missing from the response is left as it is.
+func (r *TeamResource) readTeamSettings(ctx context.Context, teamInfo map[string]interface{}, data *TeamResourceModel) {
+	metadata, _ := teamInfo["metadata"].(map[string]interface{})
+	field := func(name string) (interface{}, bool) {
+		if v, ok := teamInfo[name]; ok && v != nil {
+			return v, true
+		}
+		if v, ok := metadata[name]; ok {
+			return v, true
+		}
+		v, ok := teamInfo[name]
+		return v, ok
+	}
+
+	if v, exists := field("secret_manager_settings"); exists {
+		if settings, ok := v.(map[string]interface{}); ok && len(settings) > 0 {
+			settingsMap := make(map[string]attr.Value, len(settings))
+			for k, val := range settings {
+				settingsMap[k] = types.StringValue(metadataValueToString(val))
+			}
+			data.SecretManagerSettings, _ = types.MapValue(types.StringType, settingsMap)
+		} else if !data.SecretManagerSettings.IsNull() {
+			data.SecretManagerSettings = types.MapNull(types.StringType)
+		}
+	}
+
+	// Handle list settings - preserve null when API returns empty and config didn't specify them
+	lists := map[string]*types.List{
+		"default_team_member_models": &data.DefaultMemberModels,
+		"policies":                   &data.Policies,
+		"access_group_ids":           &data.AccessGroupIDs,
+	}
+	for name, list := range lists {
+		v, exists := field(name)
+		if !exists {
+			continue
+		}
+		if items, ok := v.([]interface{}); ok && len(items) > 0 {
+			*list = interfaceSliceToStringList(items)
+		} else if !list.IsNull() {
+			*list, _ = types.ListValue(types.StringType, []attr.Value{})
+		}
+	}
+
+	// team_member_budget_duration may only be returned on the budget that
+	// LiteLLM creates for team members.
+	readDuration := func(v interface{}, exists bool, duration *types.String) {
+		if !exists {
+			return
+		}
+		if s, ok := v.(string); ok && s != "" {
+			*duration = types.StringValue(s)
+		} else if v == nil {
+			*duration = types.StringNull()
+		}
+	}
+	v, exists := field("team_member_key_duration")
+	readDuration(v, exists, &data.MemberKeyDuration)
+	v, exists = field("team_member_budget_duration")
+	if budgetTable, ok := teamInfo["team_member_budget_table"].(map[string]interface{}); ok && !exists {
+		v, exists = budgetTable["budget_duration"]
+	}
+	readDuration(v, exists, &data.MemberBudgetDuration)
+
+	expiries := map[string]*types.Object{
+		"enforced_file_expires_after":         &data.FileExpiresAfter,
+		"enforced_batch_output_expires_after": &data.BatchExpiresAfter,
+	}
+	for name, expiry := range expiries {
+		v, exists := field(name)
+		if !exists {
+			continue
+		}
+		if expires, ok := v.(map[string]interface{}); ok && len(expires) > 0 {
+			anchor, _ := expires["anchor"].(string)
+			seconds, _ := expires["seconds"].(float64)
+			*expiry = types.ObjectValueMust(expiresAfterAttrTypes, map[string]attr.Value{
+				"anchor":  types.StringValue(anchor),
+				"seconds": types.Int64Value(int64(seconds)),
+			})
+		} else if v == nil {
+			*expiry = types.ObjectNull(expiresAfterAttrTypes)
+		}
+	}
+
+	if perm, ok := teamInfo["object_permission"].(map[string]interface{}); ok {
+		data.ObjectPermission = parseObjectPermissionFromAPI(ctx, perm, data.ObjectPermission)
+	} else if data.ObjectPermission.IsUnknown() {
+		data.ObjectPermission = types.ObjectNull(objectPermissionAttrTypes)
+	}
+}
+
+// parseTeamMembersWithRoles converts the team's members_with_roles into the
+// read-only members_with_roles list.
+func parseTeamMembersWithRoles(raw interface{}) types.List {
+	items, _ := raw.([]interface{})
+	members := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value := func(name string) types.String {
+			if v, ok := entry[name].(string); ok && v != "" {
+				return types.StringValue(v)
+			}
+			return types.StringNull()
+		}
+		members = append(members, types.ObjectValueMust(teamMemberWithRoleAttrTypes, map[string]attr.Value{
+			"user_id":    value("user_id"),
+			"user_email": value("user_email"),
+			"role":       value("role"),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: teamMemberWithRoleAttrTypes}, members)
+}
+
+// withoutTeamSettings returns a copy of the team's metadata without the
+// settings that have their own attributes.
+func withoutTeamSettings(raw interface{}) map[string]interface{} {
+	return withoutMetadataKeys(raw, teamSettingsMetadataKeys)
+}
+
+// withoutMetadataKeys returns a copy of raw metadata without keys.
+func withoutMetadataKeys(raw interface{}, keys []string) map[string]interface{} {
+	metadata, _ := raw.(map[string]interface{})
+	if metadata == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		result[k] = v
+	}
+	for _, k := range keys {
+		delete(result, k)
+	}
+	return result
+}
+
 // parseRouterSettingsFromAPI converts the LiteLLM API router_settings response
 // back into a Terraform types.Object matching the schema.
 func parseRouterSettingsFromAPI(rs map[string]interface{}) types.Object {
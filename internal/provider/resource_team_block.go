@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,9 +28,10 @@ type TeamBlockResource struct {
 }
 
 type TeamBlockResourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	TeamID  types.String `tfsdk:"team_id"`
-	Blocked types.Bool   `tfsdk:"blocked"`
+	ID       types.String   `tfsdk:"id"`
+	TeamID   types.String   `tfsdk:"team_id"`
+	Blocked  types.Bool     `tfsdk:"blocked"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TeamBlockResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,6 +64,9 @@ func (r *TeamBlockResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -90,13 +95,16 @@ func (r *TeamBlockResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Block the team
 	blockReq := map[string]interface{}{
 		"team_id": data.TeamID.ValueString(),
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/block", blockReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to block team: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to block team", err)
 		return
 	}
 
@@ -114,6 +122,9 @@ func (r *TeamBlockResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Check if the team is still blocked
 	endpoint := fmt.Sprintf("/team/info?team_id=%s", data.TeamID.ValueString())
 
@@ -124,7 +135,7 @@ func (r *TeamBlockResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read team", err)
 		return
 	}
 
@@ -160,6 +171,9 @@ func (r *TeamBlockResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state TeamBlockResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -180,6 +194,9 @@ func (r *TeamBlockResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Unblock the team
 	unblockReq := map[string]interface{}{
 		"team_id": data.TeamID.ValueString(),
@@ -187,8 +204,8 @@ func (r *TeamBlockResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/unblock", unblockReq, nil); err != nil {
 		// Don't fail if the team doesn't exist
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unblock team: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to unblock team", err)
 			return
 		}
 	}
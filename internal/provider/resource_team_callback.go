@@ -0,0 +1,497 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &TeamCallbackResource{}
+var _ resource.ResourceWithImportState = &TeamCallbackResource{}
+var _ resource.ResourceWithValidateConfig = &TeamCallbackResource{}
+
+// teamCallbackTypes are the callback_type values LiteLLM accepts.
+var teamCallbackTypes = []string{"success", "failure", "success_and_failure"}
+
+func NewTeamCallbackResource() resource.Resource {
+	return &TeamCallbackResource{}
+}
+
+type TeamCallbackResource struct {
+	client *Client
+}
+
+type TeamCallbackResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	TeamID          types.String   `tfsdk:"team_id"`
+	CallbackName    types.String   `tfsdk:"callback_name"`
+	CallbackType    types.String   `tfsdk:"callback_type"`
+	CallbackVars    types.Map      `tfsdk:"callback_vars"`
+	LoggingDisabled types.Bool     `tfsdk:"logging_disabled"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TeamCallbackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_callback"
+}
+
+func (r *TeamCallbackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a logging callback of a LiteLLM team, or disables logging for the team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite ID (team_id:callback_name), or the team ID when logging_disabled is true.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Team ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callback_name": schema.StringAttribute{
+				Description: "Name of the callback (e.g., langfuse, langsmith, gcs).",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callback_type": schema.StringAttribute{
+				Description: "When the callback runs: success, failure or success_and_failure. Defaults to success_and_failure.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("success_and_failure"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(teamCallbackTypes...),
+				},
+			},
+			"callback_vars": schema.MapAttribute{
+				Description: "Variables passed to the callback, such as langfuse_public_key, langfuse_secret_key and langfuse_host.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"logging_disabled": schema.BoolAttribute{
+				Description: "Whether all logging callbacks are disabled for the team. Cannot be combined with callback_name.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *TeamCallbackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig requires either a callback or logging_disabled, since
+// disabling logging removes every callback of the team.
+func (r *TeamCallbackResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TeamCallbackResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.LoggingDisabled.IsUnknown() || data.CallbackName.IsUnknown() {
+		return
+	}
+
+	if data.LoggingDisabled.ValueBool() {
+		if !data.CallbackName.IsNull() || !data.CallbackVars.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("logging_disabled"), "Invalid Attribute Combination",
+				"callback_name and callback_vars cannot be set when logging_disabled is true, because disabling logging removes every callback of the team.")
+		}
+		return
+	}
+	if data.CallbackName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("callback_name"), "Missing Attribute",
+			"callback_name is required unless logging_disabled is true.")
+	}
+	if data.CallbackVars.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("callback_vars"), "Missing Attribute",
+			"callback_vars is required unless logging_disabled is true.")
+	}
+}
+
+func (r *TeamCallbackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamCallbackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	teamID := data.TeamID.ValueString()
+	if data.LoggingDisabled.ValueBool() {
+		unlock := lockTeamMetadata(teamID)
+		endpoint := fmt.Sprintf("/team/%s/disable_logging", url.PathEscape(teamID))
+		err := r.client.DoRequestWithResponse(ctx, "POST", endpoint, nil, nil)
+		unlock()
+		if err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to disable team logging", err)
+			return
+		}
+		data.ID = data.TeamID
+	} else {
+		if err := r.addCallback(ctx, &data); err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to add team callback", err)
+			return
+		}
+		data.ID = types.StringValue(fmt.Sprintf("%s:%s", teamID, data.CallbackName.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamCallbackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamCallbackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	metadata, err := r.client.getTeamMetadata(ctx, data.TeamID.ValueString())
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(ctx, &resp.Diagnostics, nil, "Unable to read team callbacks", err)
+		return
+	}
+
+	settings := parseTeamCallbackSettings(metadata)
+	if data.LoggingDisabled.ValueBool() {
+		data.LoggingDisabled = types.BoolValue(settings.loggingDisabled())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	callbackType := settings.callbackType(data.CallbackName.ValueString())
+	if callbackType == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.CallbackType = types.StringValue(callbackType)
+
+	// callback_vars is shared by every callback of the team, so only the
+	// variables this resource manages are tracked. An imported callback
+	// adopts all of them.
+	vars := map[string]attr.Value{}
+	var configured map[string]string
+	if !data.CallbackVars.IsNull() {
+		data.CallbackVars.ElementsAs(ctx, &configured, false)
+	}
+	for k, v := range settings.CallbackVars {
+		if _, ok := configured[k]; ok || data.CallbackVars.IsNull() {
+			vars[k] = types.StringValue(v)
+		}
+	}
+	if len(vars) > 0 || !data.CallbackVars.IsNull() {
+		data.CallbackVars, _ = types.MapValue(types.StringType, vars)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamCallbackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TeamCallbackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	plan.ID = state.ID
+
+	// LiteLLM rejects a callback that already exists, so a changed callback
+	// is removed and added again.
+	if err := r.removeCallback(ctx, &state); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update team callback", err)
+		return
+	}
+	if err := r.addCallback(ctx, &plan); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update team callback", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamCallbackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamCallbackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.removeCallback(ctx, &data); err != nil && !isAlreadyDeletedError(err) {
+		addClientError(ctx, &resp.Diagnostics, nil, "Unable to remove team callback", err)
+	}
+}
+
+func (r *TeamCallbackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: team_id:callback_name, or team_id for a team with
+	// logging disabled.
+	teamID, callbackName, hasCallback := strings.Cut(req.ID, ":")
+	if teamID == "" || (hasCallback && callbackName == "") {
+		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be in format team_id:callback_name, or team_id for a team with logging disabled")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("logging_disabled"), !hasCallback)...)
+	if hasCallback {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("callback_name"), callbackName)...)
+	}
+}
+
+// teamMetadataLocks holds a *sync.Mutex per team ID. Callbacks are stored in
+// the team's metadata, which /team/update replaces as a whole, so changes to
+// the callbacks of one team, and litellm_team updates that carry them over,
+// are made one at a time rather than in parallel.
+var teamMetadataLocks sync.Map
+
+// lockTeamMetadata locks the metadata of a team and returns the unlock
+// function.
+func lockTeamMetadata(teamID string) func() {
+	v, _ := teamMetadataLocks.LoadOrStore(teamID, &sync.Mutex{})
+	mu := v.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+func (r *TeamCallbackResource) addCallback(ctx context.Context, data *TeamCallbackResourceModel) error {
+	defer lockTeamMetadata(data.TeamID.ValueString())()
+
+	vars := map[string]string{}
+	if !data.CallbackVars.IsNull() && !data.CallbackVars.IsUnknown() {
+		data.CallbackVars.ElementsAs(ctx, &vars, false)
+	}
+
+	callbackReq := map[string]interface{}{
+		"callback_name": data.CallbackName.ValueString(),
+		"callback_type": data.CallbackType.ValueString(),
+		"callback_vars": vars,
+	}
+	endpoint := fmt.Sprintf("/team/%s/callback", url.PathEscape(data.TeamID.ValueString()))
+	return r.client.DoRequestWithResponse(ctx, "POST", endpoint, callbackReq, nil)
+}
+
+// removeCallback removes the callback and its variables from the team's
+// callback_settings, or re-enables logging when logging_disabled is set.
+// LiteLLM has no endpoint for either, so the team's metadata is rewritten
+// through /team/update with every other key left as it is.
+func (r *TeamCallbackResource) removeCallback(ctx context.Context, data *TeamCallbackResourceModel) error {
+	teamID := data.TeamID.ValueString()
+	defer lockTeamMetadata(teamID)()
+
+	metadata, err := r.client.getTeamMetadata(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	settings := parseTeamCallbackSettings(metadata)
+	if data.LoggingDisabled.ValueBool() {
+		if !settings.loggingDisabled() {
+			return nil
+		}
+		metadata["callback_settings"] = nil
+	} else {
+		name := data.CallbackName.ValueString()
+		if settings.callbackType(name) == "" {
+			return nil
+		}
+		settings.SuccessCallback = withoutString(settings.SuccessCallback, name)
+		settings.FailureCallback = withoutString(settings.FailureCallback, name)
+		for k := range data.CallbackVars.Elements() {
+			delete(settings.CallbackVars, k)
+		}
+		metadata["callback_settings"] = map[string]interface{}{
+			"success_callback": settings.SuccessCallback,
+			"failure_callback": settings.FailureCallback,
+			"callback_vars":    settings.CallbackVars,
+		}
+		// Empty callback lists are how LiteLLM disables logging, so removing
+		// the last callback drops callback_settings instead.
+		if settings.loggingDisabled() {
+			metadata["callback_settings"] = nil
+		}
+	}
+
+	updateReq := map[string]interface{}{
+		"team_id":  teamID,
+		"metadata": metadata,
+	}
+	return r.client.DoRequestWithResponse(ctx, "POST", "/team/update", updateReq, nil)
+}
+
+// getTeamMetadata returns the metadata of a team from /team/info.
+func (c *Client) getTeamMetadata(ctx context.Context, teamID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if err := c.DoRequestWithResponse(ctx, "GET", "/team/info?team_id="+url.QueryEscape(teamID), nil, &result); err != nil {
+		return nil, err
+	}
+	teamInfo := result
+	if nested, ok := result["team_info"].(map[string]interface{}); ok {
+		teamInfo = nested
+	}
+
+	metadata, _ := teamInfo["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	return metadata, nil
+}
+
+// keepTeamCallbackSettings copies the team's current callback_settings into
+// the metadata of a /team/update request, so that updating a team's metadata
+// does not remove the callbacks litellm_team_callback manages. The caller
+// holds the team's metadata lock.
+func (c *Client) keepTeamCallbackSettings(ctx context.Context, teamID string, teamReq map[string]interface{}) error {
+	metadata, ok := teamReq["metadata"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	current, err := c.getTeamMetadata(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if settings, ok := current["callback_settings"]; ok {
+		metadata["callback_settings"] = settings
+	}
+	return nil
+}
+
+// teamCallbackSettings is the callback_settings LiteLLM keeps in a team's
+// metadata. Present records whether the team has callback_settings at all.
+type teamCallbackSettings struct {
+	Present         bool
+	SuccessCallback []string
+	FailureCallback []string
+	CallbackVars    map[string]string
+}
+
+func parseTeamCallbackSettings(metadata map[string]interface{}) teamCallbackSettings {
+	settings := teamCallbackSettings{CallbackVars: map[string]string{}}
+	raw, ok := metadata["callback_settings"].(map[string]interface{})
+	if !ok {
+		return settings
+	}
+	settings.Present = true
+
+	toStrings := func(v interface{}) []string {
+		items, _ := v.([]interface{})
+		result := make([]string, 0, len(items))
+		for _, item := range items {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	settings.SuccessCallback = toStrings(raw["success_callback"])
+	settings.FailureCallback = toStrings(raw["failure_callback"])
+	if vars, ok := raw["callback_vars"].(map[string]interface{}); ok {
+		for k, v := range vars {
+			if s, ok := v.(string); ok {
+				settings.CallbackVars[k] = s
+			}
+		}
+	}
+	return settings
+}
+
+// loggingDisabled reports whether /team/{team_id}/disable_logging was
+// applied: callback_settings is present but empty.
+func (s teamCallbackSettings) loggingDisabled() bool {
+	return s.Present && len(s.SuccessCallback) == 0 && len(s.FailureCallback) == 0
+}
+
+// callbackType returns the callback_type under which name is registered,
+// or "" when the team has no such callback.
+func (s teamCallbackSettings) callbackType(name string) string {
+	success := containsString(s.SuccessCallback, name)
+	failure := containsString(s.FailureCallback, name)
+	switch {
+	case success && failure:
+		return "success_and_failure"
+	case success:
+		return "success"
+	case failure:
+		return "failure"
+	}
+	return ""
+}
+
+func containsString(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutString(items []string, s string) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if item != s {
+			result = append(result, item)
+		}
+	}
+	return result
+}
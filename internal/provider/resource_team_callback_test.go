@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTeamCallbackResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	vars := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"langfuse_public_key": tftypes.NewValue(tftypes.String, "pk"),
+	})
+	tests := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"callback": {
+			values: map[string]tftypes.Value{
+				"callback_name": tftypes.NewValue(tftypes.String, "langfuse"),
+				"callback_vars": vars,
+			},
+		},
+		"callback without vars": {
+			values: map[string]tftypes.Value{
+				"callback_name": tftypes.NewValue(tftypes.String, "langfuse"),
+			},
+			wantErr: true,
+		},
+		"neither": {
+			values:  map[string]tftypes.Value{},
+			wantErr: true,
+		},
+		"logging disabled": {
+			values: map[string]tftypes.Value{
+				"logging_disabled": tftypes.NewValue(tftypes.Bool, true),
+			},
+		},
+		"logging disabled with callback": {
+			values: map[string]tftypes.Value{
+				"logging_disabled": tftypes.NewValue(tftypes.Bool, true),
+				"callback_name":    tftypes.NewValue(tftypes.String, "langfuse"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		tt.values["team_id"] = tftypes.NewValue(tftypes.String, "team-1")
+		diags := validateResourceConfig(t, &TeamCallbackResource{}, tt.values)
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantErr, diags)
+		}
+	}
+}
+
+func TestTeamCallbackSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := parseTeamCallbackSettings(map[string]interface{}{
+		"callback_settings": map[string]interface{}{
+			"success_callback": []interface{}{"langfuse", "langsmith"},
+			"failure_callback": []interface{}{"langfuse", "sentry"},
+			"callback_vars":    map[string]interface{}{"langfuse_host": "https://langfuse.example.com"},
+		},
+	})
+	for name, want := range map[string]string{
+		"langfuse":  "success_and_failure",
+		"langsmith": "success",
+		"sentry":    "failure",
+		"gcs":       "",
+	} {
+		if got := settings.callbackType(name); got != want {
+			t.Errorf("callbackType(%q) = %q, want %q", name, got, want)
+		}
+	}
+	if settings.loggingDisabled() {
+		t.Error("team with callbacks should not report logging disabled")
+	}
+
+	if parseTeamCallbackSettings(map[string]interface{}{}).loggingDisabled() {
+		t.Error("team without callback_settings should not report logging disabled")
+	}
+	disabled := parseTeamCallbackSettings(map[string]interface{}{
+		"callback_settings": map[string]interface{}{"success_callback": []interface{}{}, "failure_callback": []interface{}{}},
+	})
+	if !disabled.loggingDisabled() {
+		t.Error("empty callback_settings should report logging disabled")
+	}
+}
+
+func TestTeamCallbackRemoveKeepsOtherCallbacks(t *testing.T) {
+	t.Parallel()
+
+	var updates []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/team/info":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"team_id": "team-1",
+				"team_info": map[string]interface{}{
+					"team_id": "team-1",
+					"metadata": map[string]interface{}{
+						"cost_center": "eng",
+						"callback_settings": map[string]interface{}{
+							"success_callback": []interface{}{"langfuse", "langsmith"},
+							"failure_callback": []interface{}{"langfuse"},
+							"callback_vars": map[string]interface{}{
+								"langfuse_public_key": "pk",
+								"langsmith_api_key":   "ls",
+								"langfuse_secret_key": "sk",
+							},
+						},
+					},
+				},
+			})
+		case "/team/update":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			updates = append(updates, body)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"team_id": "team-1"})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	r := &TeamCallbackResource{client: &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}}
+	data := &TeamCallbackResourceModel{
+		TeamID:          types.StringValue("team-1"),
+		CallbackName:    types.StringValue("langfuse"),
+		LoggingDisabled: types.BoolValue(false),
+		CallbackVars: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"langfuse_public_key": types.StringValue("pk"),
+			"langfuse_secret_key": types.StringValue("sk"),
+		}),
+	}
+	if err := r.removeCallback(context.Background(), data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updates) != 1 {
+		t.Fatalf("expected one /team/update call, got %d", len(updates))
+	}
+
+	metadata := updates[0]["metadata"].(map[string]interface{})
+	if metadata["cost_center"] != "eng" {
+		t.Errorf("other metadata keys should be kept, got %v", metadata)
+	}
+	settings := metadata["callback_settings"].(map[string]interface{})
+	if !reflect.DeepEqual(settings["success_callback"], []interface{}{"langsmith"}) {
+		t.Errorf("success_callback = %v, want [langsmith]", settings["success_callback"])
+	}
+	if !reflect.DeepEqual(settings["failure_callback"], []interface{}{}) {
+		t.Errorf("failure_callback = %v, want []", settings["failure_callback"])
+	}
+	if !reflect.DeepEqual(settings["callback_vars"], map[string]interface{}{"langsmith_api_key": "ls"}) {
+		t.Errorf("callback_vars = %v, want only langsmith_api_key", settings["callback_vars"])
+	}
+}
+
+func TestTeamCallbackParallelRemovals(t *testing.T) {
+	t.Parallel()
+
+	// The server keeps the team's metadata and replaces it on /team/update,
+	// slowly enough that unserialized removals would overwrite each other.
+	var mu sync.Mutex
+	metadata := map[string]interface{}{
+		"callback_settings": map[string]interface{}{
+			"success_callback": []interface{}{"langfuse", "langsmith", "sentry"},
+			"failure_callback": []interface{}{},
+			"callback_vars":    map[string]interface{}{},
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/team/info":
+			mu.Lock()
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"team_id":   "team-parallel",
+				"team_info": map[string]interface{}{"metadata": metadata},
+			})
+			mu.Unlock()
+		case "/team/update":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			time.Sleep(20 * time.Millisecond)
+			mu.Lock()
+			metadata = body["metadata"].(map[string]interface{})
+			mu.Unlock()
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"team_id": "team-parallel"})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	r := &TeamCallbackResource{client: &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}}
+	var wg sync.WaitGroup
+	for _, name := range []string{"langfuse", "langsmith"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			data := &TeamCallbackResourceModel{
+				TeamID:          types.StringValue("team-parallel"),
+				CallbackName:    types.StringValue(name),
+				LoggingDisabled: types.BoolValue(false),
+				CallbackVars:    types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			}
+			if err := r.removeCallback(context.Background(), data); err != nil {
+				t.Errorf("removing %s: %v", name, err)
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	settings := parseTeamCallbackSettings(metadata)
+	if !reflect.DeepEqual(settings.SuccessCallback, []string{"sentry"}) {
+		t.Errorf("success_callback = %v, want [sentry]", settings.SuccessCallback)
+	}
+}
+
+func TestKeepTeamCallbackSettings(t *testing.T) {
+	t.Parallel()
+
+	callbacks := map[string]interface{}{"success_callback": []interface{}{"langfuse"}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"team_id": "team-1",
+			"team_info": map[string]interface{}{
+				"metadata": map[string]interface{}{"cost_center": "old", "callback_settings": callbacks},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+	teamReq := map[string]interface{}{"metadata": map[string]interface{}{"cost_center": "eng"}}
+	if err := client.keepTeamCallbackSettings(context.Background(), "team-1", teamReq); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	metadata := teamReq["metadata"].(map[string]interface{})
+	if metadata["cost_center"] != "eng" {
+		t.Errorf("configured metadata should be kept, got %v", metadata)
+	}
+	if !reflect.DeepEqual(metadata["callback_settings"], callbacks) {
+		t.Errorf("callback_settings = %v, want the team's current callbacks", metadata["callback_settings"])
+	}
+}
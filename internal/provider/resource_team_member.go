@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,12 +30,13 @@ type TeamMemberResource struct {
 }
 
 type TeamMemberResourceModel struct {
-	ID              types.String  `tfsdk:"id"`
-	TeamID          types.String  `tfsdk:"team_id"`
-	UserID          types.String  `tfsdk:"user_id"`
-	UserEmail       types.String  `tfsdk:"user_email"`
-	Role            types.String  `tfsdk:"role"`
-	MaxBudgetInTeam types.Float64 `tfsdk:"max_budget_in_team"`
+	ID              types.String   `tfsdk:"id"`
+	TeamID          types.String   `tfsdk:"team_id"`
+	UserID          types.String   `tfsdk:"user_id"`
+	UserEmail       types.String   `tfsdk:"user_email"`
+	Role            types.String   `tfsdk:"role"`
+	MaxBudgetInTeam types.Float64  `tfsdk:"max_budget_in_team"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *TeamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,6 +78,9 @@ func (r *TeamMemberResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -102,6 +109,9 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	memberReq := map[string]interface{}{
 		"member": []map[string]interface{}{
 			{
@@ -119,7 +129,7 @@ func (r *TeamMemberResource) Create(ctx context.Context, req resource.CreateRequ
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/member_add", memberReq, nil); err != nil {
 		if !isTeamMemberAlreadyInTeamError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add team member: %s", err))
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to add team member", err)
 			return
 		}
 	}
@@ -137,6 +147,9 @@ func (r *TeamMemberResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// No specific endpoint to read a single team member
 	// Maintain state as-is
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -150,6 +163,9 @@ func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state TeamMemberResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -171,7 +187,7 @@ func (r *TeamMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 	applyTeamMemberNullableClears(updateReq, &state, &data)
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/member_update", updateReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team member: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update team member", err)
 		return
 	}
 
@@ -186,6 +202,9 @@ func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"user_id":    data.UserID.ValueString(),
 		"user_email": data.UserEmail.ValueString(),
@@ -193,8 +212,8 @@ func (r *TeamMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/member_delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team member: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete team member", err)
 			return
 		}
 	}
@@ -226,6 +245,11 @@ func isTeamMemberAlreadyInTeamError(err error) bool {
 	if err == nil {
 		return false
 	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusBadRequest &&
+			(apiErr.Type == "team_member_already_in_team" || strings.Contains(apiErr.Body, "team_member_already_in_team"))
+	}
 	errStr := err.Error()
-	return contains(errStr, "status 400") && contains(errStr, "team_member_already_in_team")
+	return strings.Contains(errStr, "status 400") && strings.Contains(errStr, "team_member_already_in_team")
 }
@@ -3,9 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,16 +32,28 @@ type TeamMemberAddResource struct {
 }
 
 type TeamMemberAddResourceModel struct {
-	ID              types.String  `tfsdk:"id"`
-	TeamID          types.String  `tfsdk:"team_id"`
-	Members         types.Set     `tfsdk:"member"`
-	MaxBudgetInTeam types.Float64 `tfsdk:"max_budget_in_team"`
+	ID              types.String   `tfsdk:"id"`
+	TeamID          types.String   `tfsdk:"team_id"`
+	Members         types.Set      `tfsdk:"member"`
+	MaxBudgetInTeam types.Float64  `tfsdk:"max_budget_in_team"`
+	Authoritative   types.Bool     `tfsdk:"authoritative"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type MemberModel struct {
-	UserID    types.String `tfsdk:"user_id"`
-	UserEmail types.String `tfsdk:"user_email"`
-	Role      types.String `tfsdk:"role"`
+	UserID          types.String  `tfsdk:"user_id"`
+	UserEmail       types.String  `tfsdk:"user_email"`
+	Role            types.String  `tfsdk:"role"`
+	MaxBudgetInTeam types.Float64 `tfsdk:"max_budget_in_team"`
+}
+
+// teamMember is a member of a team as LiteLLM reports it. A nil MaxBudget
+// means the member has no budget within the team.
+type teamMember struct {
+	UserID    string
+	UserEmail string
+	Role      string
+	MaxBudget *float64
 }
 
 func (r *TeamMemberAddResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,8 +82,14 @@ func (r *TeamMemberAddResource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "Maximum budget for members in the team.",
 				Optional:    true,
 			},
+			"authoritative": schema.BoolAttribute{
+				Description: "Whether this resource owns the team's full member set. Members added outside Terraform are removed, " +
+					"and new members are added in one /team/bulk_member_add call. Defaults to false, which only manages the listed members.",
+				Optional: true,
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 			"member": schema.SetNestedBlock{
 				Description: "Team members.",
 				NestedObject: schema.NestedBlockObject{
@@ -86,6 +109,10 @@ func (r *TeamMemberAddResource) Schema(ctx context.Context, req resource.SchemaR
 								stringvalidator.OneOf("admin", "user"),
 							},
 						},
+						"max_budget_in_team": schema.Float64Attribute{
+							Description: "Maximum budget for this member in the team. Overrides the resource-level max_budget_in_team.",
+							Optional:    true,
+						},
 					},
 				},
 			},
@@ -118,19 +145,23 @@ func (r *TeamMemberAddResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	members := r.extractMembers(ctx, &data)
-
-	memberReq := map[string]interface{}{
-		"member":  members,
-		"team_id": data.TeamID.ValueString(),
-	}
-
-	if !data.MaxBudgetInTeam.IsNull() && !data.MaxBudgetInTeam.IsUnknown() {
-		memberReq["max_budget_in_team"] = data.MaxBudgetInTeam.ValueFloat64()
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// In authoritative mode the team's current members are reconciled
+	// against the plan; otherwise every listed member is added.
+	var current []teamMember
+	if data.Authoritative.ValueBool() {
+		var err error
+		current, err = r.client.getTeamMembers(ctx, data.TeamID.ValueString())
+		if err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to read team members", err)
+			return
+		}
 	}
 
-	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/member_add", memberReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add team members: %s", err))
+	if err := r.reconcileMembers(ctx, &data, current); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to add team members", err)
 		return
 	}
 
@@ -147,7 +178,28 @@ func (r *TeamMemberAddResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	// No specific endpoint to read team members
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	current, err := r.client.getTeamMembers(ctx, data.TeamID.ValueString())
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(ctx, &resp.Diagnostics, nil, "Unable to read team members", err)
+		return
+	}
+
+	// An imported resource has no members yet, so it adopts the full set.
+	adoptAll := data.Authoritative.ValueBool() || data.Members.IsNull()
+	members, diags := refreshMembers(ctx, data.Members, current, adoptAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Members = members
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -161,58 +213,28 @@ func (r *TeamMemberAddResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	plan.ID = state.ID
-
-	oldMembers := r.extractMembersMap(ctx, &state)
-	newMembers := r.extractMembersMap(ctx, &plan)
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
 
-	// Delete removed members
-	for key, oldMember := range oldMembers {
-		if _, exists := newMembers[key]; !exists {
-			deleteReq := map[string]interface{}{
-				"team_id": plan.TeamID.ValueString(),
-			}
-			if oldMember["user_id"] != "" {
-				deleteReq["user_id"] = oldMember["user_id"]
-			}
-			if oldMember["user_email"] != "" {
-				deleteReq["user_email"] = oldMember["user_email"]
-			}
-			if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/member_delete", deleteReq, nil); err != nil {
-				resp.Diagnostics.AddWarning("Delete Error", fmt.Sprintf("Failed to remove member %s: %s", key, err))
-			}
-		}
-	}
+	plan.ID = state.ID
 
-	// Add new members
-	var membersToAdd []map[string]interface{}
-	for key, newMember := range newMembers {
-		if _, exists := oldMembers[key]; !exists {
-			memberData := map[string]interface{}{
-				"role": newMember["role"],
-			}
-			if newMember["user_id"] != "" {
-				memberData["user_id"] = newMember["user_id"]
-			}
-			if newMember["user_email"] != "" {
-				memberData["user_email"] = newMember["user_email"]
-			}
-			membersToAdd = append(membersToAdd, memberData)
+	// Without authoritative, only the members in state are managed, so they
+	// stand in for the team's current members.
+	var current []teamMember
+	if plan.Authoritative.ValueBool() {
+		var err error
+		current, err = r.client.getTeamMembers(ctx, plan.TeamID.ValueString())
+		if err != nil {
+			addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to read team members", err)
+			return
 		}
+	} else {
+		current = r.extractMembers(ctx, &state)
 	}
 
-	if len(membersToAdd) > 0 {
-		memberReq := map[string]interface{}{
-			"member":  membersToAdd,
-			"team_id": plan.TeamID.ValueString(),
-		}
-		if !plan.MaxBudgetInTeam.IsNull() && !plan.MaxBudgetInTeam.IsUnknown() {
-			memberReq["max_budget_in_team"] = plan.MaxBudgetInTeam.ValueFloat64()
-		}
-		if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/member_add", memberReq, nil); err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add team members: %s", err))
-			return
-		}
+	if err := r.reconcileMembers(ctx, &plan, current); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update team members", err)
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -226,20 +248,12 @@ func (r *TeamMemberAddResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	members := r.extractMembersMap(ctx, &data)
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
 
-	for _, member := range members {
-		deleteReq := map[string]interface{}{
-			"team_id": data.TeamID.ValueString(),
-		}
-		if member["user_id"] != "" {
-			deleteReq["user_id"] = member["user_id"]
-		}
-		if member["user_email"] != "" {
-			deleteReq["user_email"] = member["user_email"]
-		}
-		if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/member_delete", deleteReq, nil); err != nil {
-			if !IsNotFoundError(err) {
+	for _, member := range r.extractMembers(ctx, &data) {
+		if err := r.deleteMember(ctx, data.TeamID.ValueString(), member); err != nil {
+			if !isAlreadyDeletedError(err) {
 				resp.Diagnostics.AddWarning("Delete Error", fmt.Sprintf("Failed to remove member: %s", err))
 			}
 		}
@@ -251,71 +265,319 @@ func (r *TeamMemberAddResource) ImportState(ctx context.Context, req resource.Im
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
 }
 
-func (r *TeamMemberAddResource) extractMembers(ctx context.Context, data *TeamMemberAddResourceModel) []map[string]interface{} {
-	if data.Members.IsNull() {
+// reconcileMembers brings the team from the current members to the ones in
+// data. Members missing from current are added, members whose role or
+// budget differ are updated, and current members that are not in data are
+// removed.
+func (r *TeamMemberAddResource) reconcileMembers(ctx context.Context, data *TeamMemberAddResourceModel, current []teamMember) error {
+	teamID := data.TeamID.ValueString()
+	desired := r.extractMembers(ctx, data)
+	authoritative := data.Authoritative.ValueBool()
+
+	var defaultBudget *float64
+	if !data.MaxBudgetInTeam.IsNull() && !data.MaxBudgetInTeam.IsUnknown() {
+		v := data.MaxBudgetInTeam.ValueFloat64()
+		defaultBudget = &v
+	}
+
+	matched := make([]bool, len(current))
+	var toAdd, toUpdate []teamMember
+	for _, member := range desired {
+		i := findTeamMember(current, member)
+		if i < 0 {
+			toAdd = append(toAdd, member)
+			continue
+		}
+		matched[i] = true
+		existing := current[i]
+		if existing.Role != member.Role || (member.MaxBudget != nil && !sameBudget(existing.MaxBudget, member.MaxBudget)) {
+			toUpdate = append(toUpdate, member)
+		}
+	}
+
+	for i, member := range current {
+		if matched[i] {
+			continue
+		}
+		if err := r.deleteMember(ctx, teamID, member); err != nil && !isAlreadyDeletedError(err) {
+			return fmt.Errorf("failed to remove member %s: %w", member.label(), err)
+		}
+	}
+
+	if len(toAdd) > 0 {
+		if err := r.addMembers(ctx, teamID, toAdd, defaultBudget, authoritative); err != nil {
+			return err
+		}
+		// Both add endpoints take a single budget, so per-member budgets are
+		// applied afterwards.
+		for _, member := range toAdd {
+			if member.MaxBudget != nil && !sameBudget(member.MaxBudget, defaultBudget) {
+				toUpdate = append(toUpdate, member)
+			}
+		}
+	}
+
+	for _, member := range toUpdate {
+		updateReq := member.request()
+		updateReq["team_id"] = teamID
+		if member.MaxBudget != nil {
+			updateReq["max_budget_in_team"] = *member.MaxBudget
+		}
+		if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/member_update", updateReq, nil); err != nil {
+			return fmt.Errorf("failed to update member %s: %w", member.label(), err)
+		}
+	}
+
+	return nil
+}
+
+// addMembers adds members through /team/bulk_member_add in authoritative
+// mode and through /team/member_add otherwise, which older LiteLLM releases
+// also serve.
+func (r *TeamMemberAddResource) addMembers(ctx context.Context, teamID string, members []teamMember, maxBudget *float64, bulk bool) error {
+	payload := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		payload = append(payload, member.request())
+	}
+
+	if !bulk {
+		memberReq := map[string]interface{}{
+			"member":  payload,
+			"team_id": teamID,
+		}
+		if maxBudget != nil {
+			memberReq["max_budget_in_team"] = *maxBudget
+		}
+		return r.client.DoRequestWithResponse(ctx, "POST", "/team/member_add", memberReq, nil)
+	}
+
+	bulkReq := map[string]interface{}{
+		"members": payload,
+		"team_id": teamID,
+	}
+	if maxBudget != nil {
+		bulkReq["max_budget_in_team"] = *maxBudget
+	}
+	var result map[string]interface{}
+	if err := r.client.DoRequestWithResponse(ctx, "POST", "/team/bulk_member_add", bulkReq, &result); err != nil {
+		return err
+	}
+
+	// The bulk endpoint reports per-member failures in a successful response.
+	var failures []string
+	results, _ := result["results"].([]interface{})
+	for _, item := range results {
+		entry, ok := item.(map[string]interface{})
+		if !ok || entry["success"] != false {
+			continue
+		}
+		who, _ := entry["user_id"].(string)
+		if email, _ := entry["user_email"].(string); who == "" {
+			who = email
+		}
+		msg, _ := entry["error"].(string)
+		failures = append(failures, fmt.Sprintf("%s: %s", who, msg))
+	}
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		return fmt.Errorf("failed to add %d member(s): %s", len(failures), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+func (r *TeamMemberAddResource) deleteMember(ctx context.Context, teamID string, member teamMember) error {
+	deleteReq := map[string]interface{}{
+		"team_id": teamID,
+	}
+	if member.UserID != "" {
+		deleteReq["user_id"] = member.UserID
+	}
+	if member.UserEmail != "" {
+		deleteReq["user_email"] = member.UserEmail
+	}
+	return r.client.DoRequestWithResponse(ctx, "POST", "/team/member_delete", deleteReq, nil)
+}
+
+func (r *TeamMemberAddResource) extractMembers(ctx context.Context, data *TeamMemberAddResourceModel) []teamMember {
+	if data.Members.IsNull() || data.Members.IsUnknown() {
 		return nil
 	}
 
-	var members []map[string]interface{}
-	elements := data.Members.Elements()
-	for _, elem := range elements {
-		obj := elem.(types.Object)
-		attrs := obj.Attributes()
+	var models []MemberModel
+	data.Members.ElementsAs(ctx, &models, false)
 
-		memberData := map[string]interface{}{
-			"role": attrs["role"].(types.String).ValueString(),
+	members := make([]teamMember, 0, len(models))
+	for _, m := range models {
+		member := teamMember{
+			UserID:    m.UserID.ValueString(),
+			UserEmail: m.UserEmail.ValueString(),
+			Role:      m.Role.ValueString(),
 		}
-		if userID := attrs["user_id"].(types.String); !userID.IsNull() && userID.ValueString() != "" {
-			memberData["user_id"] = userID.ValueString()
+		if !m.MaxBudgetInTeam.IsNull() && !m.MaxBudgetInTeam.IsUnknown() {
+			v := m.MaxBudgetInTeam.ValueFloat64()
+			member.MaxBudget = &v
 		}
-		if userEmail := attrs["user_email"].(types.String); !userEmail.IsNull() && userEmail.ValueString() != "" {
-			memberData["user_email"] = userEmail.ValueString()
+		if member.UserID != "" || member.UserEmail != "" {
+			members = append(members, member)
 		}
-		members = append(members, memberData)
 	}
 	return members
 }
 
-func (r *TeamMemberAddResource) extractMembersMap(ctx context.Context, data *TeamMemberAddResourceModel) map[string]map[string]string {
-	result := make(map[string]map[string]string)
-	if data.Members.IsNull() {
-		return result
+// getTeamMembers returns the members of a team from /team/info. Roles come
+// from members_with_roles and budgets from team_memberships.
+func (c *Client) getTeamMembers(ctx context.Context, teamID string) ([]teamMember, error) {
+	var result map[string]interface{}
+	if err := c.DoRequestWithResponse(ctx, "GET", "/team/info?team_id="+url.QueryEscape(teamID), nil, &result); err != nil {
+		return nil, err
+	}
+	teamInfo := result
+	if nested, ok := result["team_info"].(map[string]interface{}); ok {
+		teamInfo = nested
+	}
+
+	budgets := map[string]float64{}
+	memberships, ok := result["team_memberships"].([]interface{})
+	if !ok {
+		memberships, _ = teamInfo["team_memberships"].([]interface{})
+	}
+	for _, item := range memberships {
+		membership, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		userID, _ := membership["user_id"].(string)
+		budgetTable, _ := membership["litellm_budget_table"].(map[string]interface{})
+		if maxBudget, ok := budgetTable["max_budget"].(float64); ok && userID != "" {
+			budgets[userID] = maxBudget
+		}
+	}
+
+	var members []teamMember
+	roles, _ := teamInfo["members_with_roles"].([]interface{})
+	for _, item := range roles {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		member := teamMember{}
+		member.UserID, _ = entry["user_id"].(string)
+		member.UserEmail, _ = entry["user_email"].(string)
+		member.Role, _ = entry["role"].(string)
+		if maxBudget, ok := budgets[member.UserID]; ok {
+			member.MaxBudget = &maxBudget
+		}
+		members = append(members, member)
 	}
+	return members, nil
+}
 
-	elements := data.Members.Elements()
-	for _, elem := range elements {
-		obj := elem.(types.Object)
-		attrs := obj.Attributes()
+// refreshMembers rebuilds the member set from the team's current members.
+// Members that left the team are dropped and roles are refreshed, keeping
+// the identifiers each member was configured with. A member's budget is only
+// tracked once configured. With adoptAll, members that are not in prior are
+// added so Terraform plans their removal.
+func refreshMembers(ctx context.Context, prior types.Set, current []teamMember, adoptAll bool) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var priorMembers []MemberModel
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorMembers, false)...)
+	}
 
-		member := map[string]string{
-			"role": attrs["role"].(types.String).ValueString(),
+	matched := make([]bool, len(current))
+	members := make([]MemberModel, 0, len(current))
+	for _, m := range priorMembers {
+		i := findTeamMember(current, teamMember{UserID: m.UserID.ValueString(), UserEmail: m.UserEmail.ValueString()})
+		if i < 0 || matched[i] {
+			continue
+		}
+		matched[i] = true
+		m.Role = types.StringValue(current[i].Role)
+		if !m.MaxBudgetInTeam.IsNull() {
+			m.MaxBudgetInTeam = types.Float64PointerValue(current[i].MaxBudget)
 		}
+		members = append(members, m)
+	}
 
-		var key string
-		if userID := attrs["user_id"].(types.String); !userID.IsNull() && userID.ValueString() != "" {
-			member["user_id"] = userID.ValueString()
-			key = "id:" + userID.ValueString()
+	if adoptAll {
+		for i, member := range current {
+			if matched[i] {
+				continue
+			}
+			m := MemberModel{
+				UserID:          types.StringNull(),
+				UserEmail:       types.StringNull(),
+				Role:            types.StringValue(member.Role),
+				MaxBudgetInTeam: types.Float64PointerValue(member.MaxBudget),
+			}
+			if member.UserID != "" {
+				m.UserID = types.StringValue(member.UserID)
+			} else {
+				m.UserEmail = types.StringValue(member.UserEmail)
+			}
+			members = append(members, m)
 		}
-		if userEmail := attrs["user_email"].(types.String); !userEmail.IsNull() && userEmail.ValueString() != "" {
-			member["user_email"] = userEmail.ValueString()
-			if key == "" {
-				key = "email:" + userEmail.ValueString()
+	}
+
+	set, d := types.SetValueFrom(ctx, MemberObjectType(), members)
+	diags.Append(d...)
+	return set, diags
+}
+
+// findTeamMember returns the index of the member of members that member
+// refers to, matching on user_id when it is known and on user_email
+// otherwise, or -1.
+func findTeamMember(members []teamMember, member teamMember) int {
+	for i, m := range members {
+		if member.UserID != "" {
+			if m.UserID == member.UserID {
+				return i
 			}
+			continue
 		}
-		if key != "" {
-			result[key] = member
+		if member.UserEmail != "" && strings.EqualFold(m.UserEmail, member.UserEmail) {
+			return i
 		}
 	}
-	return result
+	return -1
+}
+
+func sameBudget(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// request returns the member's identifiers and role in the API format.
+func (m teamMember) request() map[string]interface{} {
+	memberData := map[string]interface{}{
+		"role": m.Role,
+	}
+	if m.UserID != "" {
+		memberData["user_id"] = m.UserID
+	}
+	if m.UserEmail != "" {
+		memberData["user_email"] = m.UserEmail
+	}
+	return memberData
+}
+
+func (m teamMember) label() string {
+	if m.UserID != "" {
+		return m.UserID
+	}
+	return m.UserEmail
 }
 
 // MemberObjectType returns the object type for members.
 func MemberObjectType() types.ObjectType {
 	return types.ObjectType{
 		AttrTypes: map[string]attr.Type{
-			"user_id":    types.StringType,
-			"user_email": types.StringType,
-			"role":       types.StringType,
+			"user_id":            types.StringType,
+			"user_email":         types.StringType,
+			"role":               types.StringType,
+			"max_budget_in_team": types.Float64Type,
 		},
 	}
 }
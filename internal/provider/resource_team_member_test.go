@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -30,6 +34,12 @@ func TestIsTeamMemberAlreadyInTeamError(t *testing.T) {
 	if isTeamMemberAlreadyInTeamError(wrongType) {
 		t.Fatal("other status 400 errors should not be treated as idempotent already-in-team")
 	}
+
+	apiErr := newAPIError("POST", "/team/member_add", http.StatusBadRequest,
+		[]byte(`{"error":{"message":"User is already in team","type":"team_member_already_in_team","param":"member","code":"400"}}`))
+	if !isTeamMemberAlreadyInTeamError(apiErr) {
+		t.Fatal("expected decoded team_member_already_in_team APIError to be idempotent")
+	}
 }
 
 func TestApplyTeamMemberNullableClears_TransitionToNull(t *testing.T) {
@@ -89,3 +99,171 @@ func TestApplyTeamMemberNullableClears_NoTransition_NoOp(t *testing.T) {
 		t.Errorf("helper overwrote stable max_budget_in_team; got %v, want 75", v)
 	}
 }
+
+// teamMembersServer serves /team/info with the given members and records the
+// bodies of the member endpoints it receives.
+func teamMembersServer(t *testing.T, members []interface{}, memberships []interface{}) (*httptest.Server, func() map[string][]map[string]interface{}) {
+	t.Helper()
+
+	var mu sync.Mutex
+	calls := map[string][]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/team/info" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"team_id":          "team-1",
+				"team_info":        map[string]interface{}{"team_id": "team-1", "members_with_roles": members},
+				"team_memberships": memberships,
+			})
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		calls[r.URL.Path] = append(calls[r.URL.Path], body)
+		mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"team_id": "team-1", "results": []interface{}{}})
+	}))
+	return server, func() map[string][]map[string]interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+}
+
+func TestGetTeamMembersReadsRolesAndBudgets(t *testing.T) {
+	t.Parallel()
+
+	server, _ := teamMembersServer(t,
+		[]interface{}{
+			map[string]interface{}{"user_id": "u1", "user_email": "a@example.com", "role": "admin"},
+			map[string]interface{}{"user_id": "u2", "user_email": nil, "role": "user"},
+		},
+		[]interface{}{
+			map[string]interface{}{"user_id": "u2", "litellm_budget_table": map[string]interface{}{"max_budget": 25.0}},
+		})
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+	members, err := client.getTeamMembers(context.Background(), "team-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(members) != 2 || members[0].Role != "admin" || members[0].UserEmail != "a@example.com" {
+		t.Fatalf("unexpected members: %+v", members)
+	}
+	if members[0].MaxBudget != nil || members[1].MaxBudget == nil || *members[1].MaxBudget != 25 {
+		t.Fatalf("expected only u2 to have a budget, got %+v", members)
+	}
+}
+
+func TestRefreshMembers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	prior, _ := types.SetValueFrom(ctx, MemberObjectType(), []MemberModel{
+		{UserID: types.StringNull(), UserEmail: types.StringValue("A@example.com"), Role: types.StringValue("user"), MaxBudgetInTeam: types.Float64Null()},
+		{UserID: types.StringValue("gone"), UserEmail: types.StringNull(), Role: types.StringValue("user"), MaxBudgetInTeam: types.Float64Null()},
+	})
+	current := []teamMember{
+		{UserID: "u1", UserEmail: "a@example.com", Role: "admin"},
+		{UserID: "outsider", Role: "user"},
+	}
+
+	refreshed, diags := refreshMembers(ctx, prior, current, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var members []MemberModel
+	refreshed.ElementsAs(ctx, &members, false)
+	if len(members) != 1 {
+		t.Fatalf("expected the removed member to be dropped and the outsider ignored, got %+v", members)
+	}
+	if !members[0].UserID.IsNull() || members[0].UserEmail.ValueString() != "A@example.com" || members[0].Role.ValueString() != "admin" {
+		t.Fatalf("expected the configured identifier and the refreshed role, got %+v", members[0])
+	}
+
+	refreshed, _ = refreshMembers(ctx, prior, current, true)
+	if len(refreshed.Elements()) != 2 {
+		t.Fatalf("expected the outsider to be adopted in authoritative mode, got %v", refreshed)
+	}
+}
+
+func TestReconcileMembersAuthoritative(t *testing.T) {
+	t.Parallel()
+
+	server, calls := teamMembersServer(t, []interface{}{
+		map[string]interface{}{"user_id": "keep", "role": "user"},
+		map[string]interface{}{"user_id": "promote", "role": "user"},
+		map[string]interface{}{"user_id": "outsider", "role": "admin"},
+	}, nil)
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &TeamMemberAddResource{client: &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}}
+	members, _ := types.SetValueFrom(ctx, MemberObjectType(), []MemberModel{
+		{UserID: types.StringValue("keep"), UserEmail: types.StringNull(), Role: types.StringValue("user"), MaxBudgetInTeam: types.Float64Null()},
+		{UserID: types.StringValue("promote"), UserEmail: types.StringNull(), Role: types.StringValue("admin"), MaxBudgetInTeam: types.Float64Null()},
+		{UserID: types.StringNull(), UserEmail: types.StringValue("new@example.com"), Role: types.StringValue("user"), MaxBudgetInTeam: types.Float64Value(5)},
+	})
+	data := &TeamMemberAddResourceModel{
+		TeamID:          types.StringValue("team-1"),
+		Members:         members,
+		MaxBudgetInTeam: types.Float64Null(),
+		Authoritative:   types.BoolValue(true),
+	}
+
+	current, err := r.client.getTeamMembers(ctx, "team-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := r.reconcileMembers(ctx, data, current); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := calls()
+	if len(got["/team/member_add"]) != 0 {
+		t.Errorf("authoritative mode should not use /team/member_add, got %v", got["/team/member_add"])
+	}
+	if adds := got["/team/bulk_member_add"]; len(adds) != 1 || len(adds[0]["members"].([]interface{})) != 1 {
+		t.Fatalf("expected one bulk add with the new member, got %v", adds)
+	}
+	if deletes := got["/team/member_delete"]; len(deletes) != 1 || deletes[0]["user_id"] != "outsider" {
+		t.Errorf("expected the outsider to be removed, got %v", deletes)
+	}
+	updates := got["/team/member_update"]
+	if len(updates) != 2 {
+		t.Fatalf("expected a role update and a budget update, got %v", updates)
+	}
+	for _, update := range updates {
+		switch {
+		case update["user_id"] == "promote" && update["role"] == "admin":
+		case update["user_email"] == "new@example.com" && update["max_budget_in_team"] == 5.0:
+		default:
+			t.Errorf("unexpected member update: %v", update)
+		}
+	}
+}
+
+func TestAddMembersReportsBulkFailures(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"team_id": "team-1",
+			"results": []interface{}{
+				map[string]interface{}{"user_id": "u1", "success": true},
+				map[string]interface{}{"user_email": "b@example.com", "success": false, "error": "user not found"},
+			},
+			"failed_additions": 1,
+		})
+	}))
+	defer server.Close()
+
+	r := &TeamMemberAddResource{client: &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}}
+	err := r.addMembers(context.Background(), "team-1", []teamMember{{UserID: "u1", Role: "user"}, {UserEmail: "b@example.com", Role: "user"}}, nil, true)
+	if err == nil || !strings.Contains(err.Error(), "b@example.com: user not found") {
+		t.Fatalf("expected the failed member to be reported, got %v", err)
+	}
+}
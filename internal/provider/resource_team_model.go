@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &TeamModelResource{}
+var _ resource.ResourceWithImportState = &TeamModelResource{}
+
+func NewTeamModelResource() resource.Resource {
+	return &TeamModelResource{}
+}
+
+type TeamModelResource struct {
+	client *Client
+}
+
+type TeamModelResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	TeamID   types.String   `tfsdk:"team_id"`
+	Model    types.String   `tfsdk:"model"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TeamModelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_model"
+}
+
+func (r *TeamModelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants a LiteLLM team access to a single model, without managing the team's full model list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite ID (team_id:model).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "Team ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model": schema.StringAttribute{
+				Description: "Model name or access group to grant to the team.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *TeamModelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamModelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.client.addTeamModel(ctx, data.TeamID.ValueString(), data.Model.ValueString()); err != nil {
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to add team model", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.TeamID.ValueString(), data.Model.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamModelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamModelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	// Only this model is checked, so grants from other workspaces or from
+	// litellm_team.models do not show up as drift.
+	models, err := r.client.getTeamModels(ctx, data.TeamID.ValueString())
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addClientError(ctx, &resp.Diagnostics, nil, "Unable to read team models", err)
+		return
+	}
+	if !containsString(models, data.Model.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamModelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// team_id and model force replacement, so only timeouts can change here.
+	var state TeamModelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamModelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamModelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if err := r.client.removeTeamModel(ctx, data.TeamID.ValueString(), data.Model.ValueString()); err != nil {
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete team model", err)
+			return
+		}
+	}
+}
+
+func (r *TeamModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: team_id:model. Model names may contain colons
+	// (e.g. bedrock model IDs), so only the first one separates the parts.
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", "Import ID must be in format team_id:model")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("model"), parts[1])...)
+}
+
+// addTeamModel adds model to the team's model list. A team with an empty
+// list can use every model on the proxy, and adding a model would restrict
+// it to that model alone, so such teams are refused.
+func (c *Client) addTeamModel(ctx context.Context, teamID, model string) error {
+	models, err := c.getTeamModels(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if len(models) == 0 {
+		return fmt.Errorf("team %s has no model list, so it can use every model on the proxy; granting %q would restrict it to that model only. Set models on the team first, e.g. [\"all-proxy-models\"] to keep access to every model", teamID, model)
+	}
+
+	addReq := map[string]interface{}{
+		"team_id": teamID,
+		"models":  []string{model},
+	}
+	return c.DoRequestWithResponse(ctx, "POST", "/team/model/add", addReq, nil)
+}
+
+// removeTeamModel removes model from the team's model list. Removing the
+// last model would leave the list empty, which gives the team access to
+// every model on the proxy, so that is refused.
+func (c *Client) removeTeamModel(ctx context.Context, teamID, model string) error {
+	models, err := c.getTeamModels(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if !containsString(models, model) {
+		return nil
+	}
+	if len(models) == 1 {
+		return fmt.Errorf("%q is the last model of team %s; removing it would leave the team's model list empty, which gives it access to every model on the proxy. Manage models on the litellm_team resource instead, or grant another model to the team first", model, teamID)
+	}
+
+	deleteReq := map[string]interface{}{
+		"team_id": teamID,
+		"models":  []string{model},
+	}
+	return c.DoRequestWithResponse(ctx, "POST", "/team/model/delete", deleteReq, nil)
+}
+
+// getTeamModels returns the models a team can access from /team/info.
+func (c *Client) getTeamModels(ctx context.Context, teamID string) ([]string, error) {
+	var result map[string]interface{}
+	if err := c.DoRequestWithResponse(ctx, "GET", "/team/info?team_id="+url.QueryEscape(teamID), nil, &result); err != nil {
+		return nil, err
+	}
+	teamInfo := result
+	if nested, ok := result["team_info"].(map[string]interface{}); ok {
+		teamInfo = nested
+	}
+
+	items, _ := teamInfo["models"].([]interface{})
+	models := make([]string, 0, len(items))
+	for _, item := range items {
+		if model, ok := item.(string); ok {
+			models = append(models, model)
+		}
+	}
+	return models, nil
+}
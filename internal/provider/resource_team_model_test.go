@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetTeamModels(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/team/info" || r.URL.Query().Get("team_id") != "team-1" {
+			t.Errorf("unexpected request %s", r.URL.String())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"team_id": "team-1",
+			"team_info": map[string]interface{}{
+				"team_id": "team-1",
+				"models":  []interface{}{"gpt-4o", "bedrock/anthropic.claude-3:0"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+	models, err := client.getTeamModels(context.Background(), "team-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"gpt-4o", "bedrock/anthropic.claude-3:0"}; !reflect.DeepEqual(models, want) {
+		t.Fatalf("models = %v, want %v", models, want)
+	}
+}
+
+func TestAddTeamModelRefusesTeamWithoutModelList(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		models  []interface{}
+		wantAdd bool
+	}{
+		"no model list":    {models: []interface{}{}},
+		"existing list":    {models: []interface{}{"gpt-4o-mini"}, wantAdd: true},
+		"all proxy models": {models: []interface{}{"all-proxy-models"}, wantAdd: true},
+	}
+
+	for name, tt := range tests {
+		added := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/team/info":
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"team_id":   "team-1",
+					"team_info": map[string]interface{}{"team_id": "team-1", "models": tt.models},
+				})
+			case "/team/model/add":
+				added = true
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"team_id": "team-1"})
+			default:
+				t.Errorf("%s: unexpected request to %s", name, r.URL.Path)
+			}
+		}))
+
+		client := &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+		err := client.addTeamModel(context.Background(), "team-1", "gpt-4o")
+		server.Close()
+
+		if added != tt.wantAdd {
+			t.Errorf("%s: expected model to be added %t", name, tt.wantAdd)
+		}
+		if tt.wantAdd && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if !tt.wantAdd && (err == nil || !strings.Contains(err.Error(), "every model")) {
+			t.Errorf("%s: expected an error explaining the team can use every model, got %v", name, err)
+		}
+	}
+}
+
+func TestRemoveTeamModelRefusesLastModel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		models     []interface{}
+		wantDelete bool
+		wantErr    bool
+	}{
+		"last model":    {models: []interface{}{"gpt-4o"}, wantErr: true},
+		"other models":  {models: []interface{}{"gpt-4o", "gpt-4o-mini"}, wantDelete: true},
+		"already gone":  {models: []interface{}{"gpt-4o-mini"}},
+		"no model list": {models: []interface{}{}},
+	}
+
+	for name, tt := range tests {
+		deleted := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/team/info":
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"team_id":   "team-1",
+					"team_info": map[string]interface{}{"team_id": "team-1", "models": tt.models},
+				})
+			case "/team/model/delete":
+				deleted = true
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"team_id": "team-1"})
+			default:
+				t.Errorf("%s: unexpected request to %s", name, r.URL.Path)
+			}
+		}))
+
+		client := &Client{APIBase: server.URL, APIKey: "test", HTTPClient: server.Client()}
+		err := client.removeTeamModel(context.Background(), "team-1", "gpt-4o")
+		server.Close()
+
+		if deleted != tt.wantDelete {
+			t.Errorf("%s: expected model to be deleted %t", name, tt.wantDelete)
+		}
+		if !tt.wantErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if tt.wantErr && (err == nil || !strings.Contains(err.Error(), "litellm_team")) {
+			t.Errorf("%s: expected an error pointing to litellm_team, got %v", name, err)
+		}
+	}
+}
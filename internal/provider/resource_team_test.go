@@ -521,15 +521,28 @@ func TestApplyTeamNullableClears_AllTransitionsEmitNull(t *testing.T) {
 		TeamMemberBudget:   types.Float64Value(50),
 		TeamMemberRPMLimit: types.Int64Value(10),
 		TeamMemberTPMLimit: types.Int64Value(500),
+		SecretManagerSettings: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"aws_region_name": types.StringValue("us-east-1"),
+		}),
+		MemberKeyDuration:    types.StringValue("30d"),
+		MemberBudgetDuration: types.StringValue("1mo"),
+		FileExpiresAfter: types.ObjectValueMust(expiresAfterAttrTypes, map[string]attr.Value{
+			"anchor":  types.StringValue("created_at"),
+			"seconds": types.Int64Value(3600),
+		}),
 	}
 	plan := &TeamResourceModel{
-		MaxBudget:          types.Float64Null(),
-		BudgetDuration:     types.StringNull(),
-		TPMLimit:           types.Int64Null(),
-		RPMLimit:           types.Int64Null(),
-		TeamMemberBudget:   types.Float64Null(),
-		TeamMemberRPMLimit: types.Int64Null(),
-		TeamMemberTPMLimit: types.Int64Null(),
+		MaxBudget:             types.Float64Null(),
+		BudgetDuration:        types.StringNull(),
+		TPMLimit:              types.Int64Null(),
+		RPMLimit:              types.Int64Null(),
+		TeamMemberBudget:      types.Float64Null(),
+		TeamMemberRPMLimit:    types.Int64Null(),
+		TeamMemberTPMLimit:    types.Int64Null(),
+		SecretManagerSettings: types.MapNull(types.StringType),
+		MemberKeyDuration:     types.StringNull(),
+		MemberBudgetDuration:  types.StringNull(),
+		FileExpiresAfter:      types.ObjectNull(expiresAfterAttrTypes),
 	}
 
 	teamReq := map[string]interface{}{"team_id": "team-123"}
@@ -538,6 +551,8 @@ func TestApplyTeamNullableClears_AllTransitionsEmitNull(t *testing.T) {
 	expectedNullKeys := []string{
 		"max_budget", "budget_duration", "tpm_limit", "rpm_limit",
 		"team_member_budget", "team_member_rpm_limit", "team_member_tpm_limit",
+		"secret_manager_settings", "team_member_key_duration", "team_member_budget_duration",
+		"enforced_file_expires_after",
 	}
 	for _, k := range expectedNullKeys {
 		v, ok := teamReq[k]
@@ -601,3 +616,184 @@ func TestApplyTeamNullableClears_NoTransition_NoOp(t *testing.T) {
 		t.Errorf("helper overwrote stable max_budget; got %v, want 200", v)
 	}
 }
+
+func TestReadTeamReadsSettings(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/team/info":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"team_id": "team-1",
+				"team_info": map[string]interface{}{
+					"team_alias":                 "settings-team",
+					"default_team_member_models": []interface{}{"gpt-4o-mini"},
+					"access_group_ids":           []interface{}{},
+					"metadata": map[string]interface{}{
+						"environment":              "prod",
+						"team_member_key_duration": "30d",
+						"policies":                 []interface{}{"pii-policy"},
+						"secret_manager_settings": map[string]interface{}{
+							"aws_region_name": "us-east-1",
+							"tags":            map[string]interface{}{"team": "ml"},
+						},
+						"enforced_file_expires_after": map[string]interface{}{"anchor": "created_at", "seconds": 86400},
+						"callback_settings": map[string]interface{}{
+							"success_callback": []interface{}{"langfuse"},
+						},
+					},
+					"team_member_budget_table": map[string]interface{}{"budget_duration": "1mo"},
+					"members_with_roles": []interface{}{
+						map[string]interface{}{"user_id": "user-1", "user_email": "a@example.com", "role": "admin"},
+						map[string]interface{}{"user_id": "user-2", "user_email": nil, "role": "user"},
+					},
+					"object_permission": map[string]interface{}{
+						"mcp_servers": []interface{}{"github"},
+					},
+				},
+			})
+		case "/team/permissions_list":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	r := &TeamResource{client: &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client()}}
+	data := TeamResourceModel{
+		ID:                    types.StringValue("team-1"),
+		Metadata:              types.MapUnknown(types.StringType),
+		SecretManagerSettings: types.MapNull(types.StringType),
+		DefaultMemberModels:   types.ListNull(types.StringType),
+		FileExpiresAfter:      types.ObjectNull(expiresAfterAttrTypes),
+		BatchExpiresAfter:     types.ObjectNull(expiresAfterAttrTypes),
+		ObjectPermission:      types.ObjectNull(objectPermissionAttrTypes),
+		Policies:              types.ListNull(types.StringType),
+		AccessGroupIDs:        types.ListNull(types.StringType),
+	}
+
+	if err := r.readTeam(context.Background(), &data); err != nil {
+		t.Fatalf("readTeam returned error: %v", err)
+	}
+
+	if got := data.SecretManagerSettings.Elements()["tags"]; got != types.StringValue(`{"team":"ml"}`) {
+		t.Errorf("secret_manager_settings.tags = %v, want JSON object", got)
+	}
+	if got := listToStringSlice(data.DefaultMemberModels); len(got) != 1 || got[0] != "gpt-4o-mini" {
+		t.Errorf("default_team_member_models = %v", got)
+	}
+	if got := listToStringSlice(data.Policies); len(got) != 1 || got[0] != "pii-policy" {
+		t.Errorf("policies = %v, want value from metadata", got)
+	}
+	if !data.AccessGroupIDs.IsNull() {
+		t.Errorf("access_group_ids should stay null when unset and empty, got %v", data.AccessGroupIDs)
+	}
+	if data.MemberKeyDuration.ValueString() != "30d" || data.MemberBudgetDuration.ValueString() != "1mo" {
+		t.Errorf("durations = %v, %v", data.MemberKeyDuration, data.MemberBudgetDuration)
+	}
+
+	var expires ExpiresAfterModel
+	data.FileExpiresAfter.As(context.Background(), &expires, basetypes.ObjectAsOptions{})
+	if expires.Anchor.ValueString() != "created_at" || expires.Seconds.ValueInt64() != 86400 {
+		t.Errorf("enforced_file_expires_after = %v", data.FileExpiresAfter)
+	}
+	if !data.BatchExpiresAfter.IsNull() {
+		t.Errorf("enforced_batch_output_expires_after should stay null, got %v", data.BatchExpiresAfter)
+	}
+	if data.ObjectPermission.IsNull() {
+		t.Error("object_permission should be read back")
+	}
+
+	// Settings kept in metadata must not also show up in metadata.
+	if elems := data.Metadata.Elements(); len(elems) != 1 || elems["environment"] != types.StringValue("prod") {
+		t.Errorf("metadata = %v, want only environment", elems)
+	}
+
+	members := data.MembersWithRoles.Elements()
+	if len(members) != 2 {
+		t.Fatalf("members_with_roles = %v, want 2 members", data.MembersWithRoles)
+	}
+	second := members[1].(types.Object).Attributes()
+	if second["user_id"] != types.StringValue("user-2") || second["role"] != types.StringValue("user") || !second["user_email"].IsNull() {
+		t.Errorf("unexpected member %v", second)
+	}
+}
+
+func TestApplyTeamNullableClears_ObjectPermissionFields(t *testing.T) {
+	t.Parallel()
+
+	perm := func(vectorStores types.List) types.Object {
+		return types.ObjectValueMust(objectPermissionAttrTypes, map[string]attr.Value{
+			"mcp_servers":          types.ListValueMust(types.StringType, []attr.Value{types.StringValue("github")}),
+			"mcp_access_groups":    types.ListNull(types.StringType),
+			"mcp_tool_permissions": types.MapNull(types.ListType{ElemType: types.StringType}),
+			"vector_stores":        vectorStores,
+			"agents":               types.ListNull(types.StringType),
+		})
+	}
+	state := &TeamResourceModel{ObjectPermission: perm(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("vs-1")}))}
+	plan := &TeamResourceModel{ObjectPermission: perm(types.ListNull(types.StringType))}
+
+	teamReq := map[string]interface{}{
+		"object_permission": buildObjectPermissionPayload(context.Background(), plan.ObjectPermission),
+	}
+	applyTeamNullableClears(teamReq, state, plan)
+
+	got := teamReq["object_permission"].(map[string]interface{})
+	if stores, ok := got["vector_stores"].([]string); !ok || len(stores) != 0 {
+		t.Errorf("removed vector_stores should be sent empty, got %v", got["vector_stores"])
+	}
+	if servers, ok := got["mcp_servers"].([]string); !ok || len(servers) != 1 {
+		t.Errorf("configured mcp_servers should be kept, got %v", got["mcp_servers"])
+	}
+}
+
+func TestBuildTeamRequestIncludesSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &TeamResource{client: &Client{}}
+	data := &TeamResourceModel{
+		TeamAlias: types.StringValue("settings-team"),
+		SecretManagerSettings: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"aws_region_name": types.StringValue("us-east-1"),
+			"tags":            types.StringValue(`{"team":"ml"}`),
+		}),
+		DefaultMemberModels:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("gpt-4o-mini")}),
+		MemberKeyDuration:    types.StringValue("30d"),
+		MemberBudgetDuration: types.StringValue("1mo"),
+		BatchExpiresAfter: types.ObjectValueMust(expiresAfterAttrTypes, map[string]attr.Value{
+			"anchor":  types.StringValue("created_at"),
+			"seconds": types.Int64Value(3600),
+		}),
+		Policies:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("pii-policy")}),
+		AccessGroupIDs: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ag-1")}),
+	}
+
+	teamReq := r.buildTeamRequest(ctx, data, "team-1")
+
+	settings, ok := teamReq["secret_manager_settings"].(map[string]interface{})
+	if !ok || settings["aws_region_name"] != "us-east-1" {
+		t.Fatalf("secret_manager_settings = %v", teamReq["secret_manager_settings"])
+	}
+	if _, ok := settings["tags"].(map[string]interface{}); !ok {
+		t.Errorf("secret_manager_settings.tags should be sent as a JSON object, got %T", settings["tags"])
+	}
+	if teamReq["team_member_key_duration"] != "30d" || teamReq["team_member_budget_duration"] != "1mo" {
+		t.Errorf("durations = %v, %v", teamReq["team_member_key_duration"], teamReq["team_member_budget_duration"])
+	}
+	if expires, ok := teamReq["enforced_batch_output_expires_after"].(map[string]interface{}); !ok || expires["seconds"] != int64(3600) {
+		t.Errorf("enforced_batch_output_expires_after = %v", teamReq["enforced_batch_output_expires_after"])
+	}
+	if _, ok := teamReq["enforced_file_expires_after"]; ok {
+		t.Error("unset enforced_file_expires_after should be omitted")
+	}
+	for _, k := range []string{"default_team_member_models", "policies", "access_group_ids"} {
+		if got, ok := teamReq[k].([]string); !ok || len(got) != 1 {
+			t.Errorf("%s = %v", k, teamReq[k])
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,19 +26,20 @@ type UnifiedAccessGroupResource struct {
 }
 
 type UnifiedAccessGroupResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	AccessGroupID      types.String `tfsdk:"access_group_id"`
-	AccessGroupName    types.String `tfsdk:"access_group_name"`
-	Description        types.String `tfsdk:"description"`
-	AccessModelNames   types.List   `tfsdk:"access_model_names"`
-	AccessMCPServerIDs types.List   `tfsdk:"access_mcp_server_ids"`
-	AccessAgentIDs     types.List   `tfsdk:"access_agent_ids"`
-	AssignedTeamIDs    types.List   `tfsdk:"assigned_team_ids"`
-	AssignedKeyIDs     types.List   `tfsdk:"assigned_key_ids"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	CreatedBy          types.String `tfsdk:"created_by"`
-	UpdatedAt          types.String `tfsdk:"updated_at"`
-	UpdatedBy          types.String `tfsdk:"updated_by"`
+	ID                 types.String   `tfsdk:"id"`
+	AccessGroupID      types.String   `tfsdk:"access_group_id"`
+	AccessGroupName    types.String   `tfsdk:"access_group_name"`
+	Description        types.String   `tfsdk:"description"`
+	AccessModelNames   types.List     `tfsdk:"access_model_names"`
+	AccessMCPServerIDs types.List     `tfsdk:"access_mcp_server_ids"`
+	AccessAgentIDs     types.List     `tfsdk:"access_agent_ids"`
+	AssignedTeamIDs    types.List     `tfsdk:"assigned_team_ids"`
+	AssignedKeyIDs     types.List     `tfsdk:"assigned_key_ids"`
+	CreatedAt          types.String   `tfsdk:"created_at"`
+	CreatedBy          types.String   `tfsdk:"created_by"`
+	UpdatedAt          types.String   `tfsdk:"updated_at"`
+	UpdatedBy          types.String   `tfsdk:"updated_by"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *UnifiedAccessGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -117,6 +119,9 @@ func (r *UnifiedAccessGroupResource) Schema(ctx context.Context, req resource.Sc
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -139,10 +144,13 @@ func (r *UnifiedAccessGroupResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	createReq := buildUnifiedAccessGroupRequest(ctx, &data, false)
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/v1/access_group", createReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create unified access group: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create unified access group", err)
 		return
 	}
 
@@ -157,12 +165,15 @@ func (r *UnifiedAccessGroupResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readUnifiedAccessGroup(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read unified access group: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read unified access group", err)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -174,6 +185,9 @@ func (r *UnifiedAccessGroupResource) Update(ctx context.Context, req resource.Up
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
 	var state UnifiedAccessGroupResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -186,7 +200,7 @@ func (r *UnifiedAccessGroupResource) Update(ctx context.Context, req resource.Up
 	endpoint := fmt.Sprintf("/v1/access_group/%s", data.AccessGroupID.ValueString())
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "PUT", endpoint, updateReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update unified access group: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update unified access group", err)
 		return
 	}
 
@@ -200,14 +214,17 @@ func (r *UnifiedAccessGroupResource) Delete(ctx context.Context, req resource.De
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
 	id := data.AccessGroupID.ValueString()
 	if id == "" {
 		id = data.ID.ValueString()
 	}
 	endpoint := fmt.Sprintf("/v1/access_group/%s", id)
 	if err := r.client.DoRequestWithResponse(ctx, "DELETE", endpoint, nil, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete unified access group: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete unified access group", err)
 		}
 	}
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,6 +20,8 @@ import (
 
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithIdentity = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
 
 func NewUserResource() resource.Resource {
 	return &UserResource{}
@@ -28,20 +32,31 @@ type UserResource struct {
 }
 
 type UserResourceModel struct {
-	ID             types.String  `tfsdk:"id"`
-	UserID         types.String  `tfsdk:"user_id"`
-	UserAlias      types.String  `tfsdk:"user_alias"`
-	UserEmail      types.String  `tfsdk:"user_email"`
-	UserRole       types.String  `tfsdk:"user_role"`
-	Teams          types.List    `tfsdk:"teams"`
-	Models         types.List    `tfsdk:"models"`
-	MaxBudget      types.Float64 `tfsdk:"max_budget"`
-	BudgetDuration types.String  `tfsdk:"budget_duration"`
-	TPMLimit       types.Int64   `tfsdk:"tpm_limit"`
-	RPMLimit       types.Int64   `tfsdk:"rpm_limit"`
-	AutoCreateKey  types.Bool    `tfsdk:"auto_create_key"`
-	Metadata       types.Map     `tfsdk:"metadata"`
-	Key            types.String  `tfsdk:"key"`
+	ID             types.String   `tfsdk:"id"`
+	UserID         types.String   `tfsdk:"user_id"`
+	UserAlias      types.String   `tfsdk:"user_alias"`
+	UserEmail      types.String   `tfsdk:"user_email"`
+	UserRole       types.String   `tfsdk:"user_role"`
+	Teams          types.List     `tfsdk:"teams"`
+	Models         types.List     `tfsdk:"models"`
+	MaxBudget      types.Float64  `tfsdk:"max_budget"`
+	BudgetDuration types.String   `tfsdk:"budget_duration"`
+	BudgetLimits   types.List     `tfsdk:"budget_limits"`
+	TPMLimit       types.Int64    `tfsdk:"tpm_limit"`
+	RPMLimit       types.Int64    `tfsdk:"rpm_limit"`
+	AutoCreateKey  types.Bool     `tfsdk:"auto_create_key"`
+	Metadata       types.Map      `tfsdk:"metadata"`
+	MetadataAll    types.Map      `tfsdk:"metadata_all"`
+	Key            types.String   `tfsdk:"key"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+type UserIdentityModel struct {
+	UserID types.String `tfsdk:"user_id"`
+}
+
+func userIdentity(data *UserResourceModel) UserIdentityModel {
+	return UserIdentityModel{UserID: data.UserID}
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -109,7 +124,11 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			"budget_duration": schema.StringAttribute{
 				Description: "Budget reset duration (e.g., '30s', '30m', '30h', '30d', '1mo').",
 				Optional:    true,
+				Validators: []validator.String{
+					litellmDuration(),
+				},
 			},
+			"budget_limits": budgetLimitsSchemaAttribute("user"),
 			"tpm_limit": schema.Int64Attribute{
 				Description: "Tokens per minute limit for the user.",
 				Optional:    true,
@@ -124,6 +143,7 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"metadata_all": metadataAllSchemaAttribute(),
 			"metadata": schema.MapAttribute{
 				Description: "Metadata for the user.",
 				Optional:    true,
@@ -139,6 +159,20 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *UserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"user_id": identityschema.StringAttribute{
+				Description:       "The user ID.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
 
@@ -159,6 +193,10 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.client.planDefaults(ctx, req, resp, "metadata", false)
+}
+
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data UserResourceModel
 
@@ -167,11 +205,14 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	userReq := r.buildUserRequest(ctx, &data)
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/user/new", userReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create user", err)
 		return
 	}
 
@@ -192,6 +233,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, userIdentity(&data))
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -202,16 +244,20 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readUser(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read user", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, userIdentity(&data))
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -222,6 +268,9 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state UserResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -235,9 +284,14 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	userReq := r.buildUserRequest(ctx, &data)
 	userReq["user_id"] = data.UserID.ValueString()
+	// LiteLLM ignores omitted fields, so removed budget windows must be
+	// cleared explicitly.
+	if !state.BudgetLimits.IsNull() && data.BudgetLimits.IsNull() {
+		userReq["budget_limits"] = nil
+	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/user/update", userReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update user", err)
 		return
 	}
 
@@ -247,6 +301,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setResourceIdentity(ctx, resp.Identity, &resp.Diagnostics, userIdentity(&data))
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -257,21 +312,25 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	deleteReq := map[string]interface{}{
 		"user_ids": []string{data.UserID.ValueString()},
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/user/delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete user", err)
 			return
 		}
 	}
 }
 
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), req.ID)...)
+	userID := importID(ctx, req, "user_id", &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
 }
 
 func (r *UserResource) buildUserRequest(ctx context.Context, data *UserResourceModel) map[string]interface{} {
@@ -310,6 +369,10 @@ func (r *UserResource) buildUserRequest(ctx context.Context, data *UserResourceM
 		userReq["auto_create_key"] = data.AutoCreateKey.ValueBool()
 	}
 
+	if !data.BudgetLimits.IsNull() && !data.BudgetLimits.IsUnknown() {
+		userReq["budget_limits"] = buildBudgetLimitsPayload(ctx, data.BudgetLimits)
+	}
+
 	// List fields - check IsNull, IsUnknown, and len > 0
 	if !data.Teams.IsNull() && !data.Teams.IsUnknown() {
 		var teams []string
@@ -336,6 +399,8 @@ func (r *UserResource) buildUserRequest(ctx context.Context, data *UserResourceM
 		}
 	}
 
+	r.client.mergeDefaultMetadata(userReq)
+
 	return userReq
 }
 
@@ -375,6 +440,7 @@ func (r *UserResource) readUser(ctx context.Context, data *UserResourceModel) er
 	if budgetDuration, ok := userInfo["budget_duration"].(string); ok && !data.BudgetDuration.IsNull() {
 		data.BudgetDuration = types.StringValue(budgetDuration)
 	}
+	data.BudgetLimits = parseBudgetLimitsFromAPI(userInfo["budget_limits"], data.BudgetLimits)
 
 	// Numeric fields. These are Optional-only, so avoid writing API-injected
 	// defaults into state when the user did not configure them.
@@ -417,7 +483,8 @@ func (r *UserResource) readUser(ctx context.Context, data *UserResourceModel) er
 	}
 
 	// Handle metadata map - preserve null when API returns empty and config didn't specify metadata
-	if metadata, ok := userInfo["metadata"].(map[string]interface{}); ok && len(metadata) > 0 {
+	apiMetadata, _ := userInfo["metadata"].(map[string]interface{})
+	if metadata := r.client.withoutDefaultMetadata(ctx, apiMetadata, data.Metadata); len(metadata) > 0 {
 		metaMap := make(map[string]attr.Value)
 		for k, v := range metadata {
 			if str, ok := v.(string); ok {
@@ -429,6 +496,7 @@ func (r *UserResource) readUser(ctx context.Context, data *UserResourceModel) er
 		// User specified metadata in config but API returned empty — set to empty map
 		data.Metadata, _ = types.MapValue(types.StringType, map[string]attr.Value{})
 	}
+	data.MetadataAll = r.client.metadataAllFromAPI(ctx, data.Metadata, apiMetadata)
 
 	return nil
 }
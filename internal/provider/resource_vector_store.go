@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,15 +27,16 @@ type VectorStoreResource struct {
 }
 
 type VectorStoreResourceModel struct {
-	ID                     types.String `tfsdk:"id"`
-	VectorStoreID          types.String `tfsdk:"vector_store_id"`
-	VectorStoreName        types.String `tfsdk:"vector_store_name"`
-	CustomLLMProvider      types.String `tfsdk:"custom_llm_provider"`
-	VectorStoreDescription types.String `tfsdk:"vector_store_description"`
-	VectorStoreMetadata    types.Map    `tfsdk:"vector_store_metadata"`
-	LiteLLMCredentialName  types.String `tfsdk:"litellm_credential_name"`
-	LiteLLMParams          types.Map    `tfsdk:"litellm_params"`
-	CreatedAt              types.String `tfsdk:"created_at"`
+	ID                     types.String   `tfsdk:"id"`
+	VectorStoreID          types.String   `tfsdk:"vector_store_id"`
+	VectorStoreName        types.String   `tfsdk:"vector_store_name"`
+	CustomLLMProvider      types.String   `tfsdk:"custom_llm_provider"`
+	VectorStoreDescription types.String   `tfsdk:"vector_store_description"`
+	VectorStoreMetadata    types.Map      `tfsdk:"vector_store_metadata"`
+	LiteLLMCredentialName  types.String   `tfsdk:"litellm_credential_name"`
+	LiteLLMParams          types.Map      `tfsdk:"litellm_params"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *VectorStoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -95,6 +97,9 @@ func (r *VectorStoreResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -123,6 +128,9 @@ func (r *VectorStoreResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Generate a UUID for vector_store_id if not already set
 	vsID := uuid.New().String()
 	data.VectorStoreID = types.StringValue(vsID)
@@ -133,7 +141,7 @@ func (r *VectorStoreResource) Create(ctx context.Context, req resource.CreateReq
 
 	var result map[string]interface{}
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/vector_store/new", vsReq, &result); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create vector store: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to create vector store", err)
 		return
 	}
 
@@ -153,12 +161,15 @@ func (r *VectorStoreResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	if err := r.readVectorStore(ctx, &data); err != nil {
 		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vector store: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to read vector store", err)
 		return
 	}
 
@@ -173,6 +184,9 @@ func (r *VectorStoreResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var state VectorStoreResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -187,7 +201,7 @@ func (r *VectorStoreResource) Update(ctx context.Context, req resource.UpdateReq
 	vsReq["vector_store_id"] = data.VectorStoreID.ValueString()
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/vector_store/update", vsReq, nil); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update vector store: %s", err))
+		addClientError(ctx, &resp.Diagnostics, req.Plan.Schema, "Unable to update vector store", err)
 		return
 	}
 
@@ -207,6 +221,9 @@ func (r *VectorStoreResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	vectorStoreID := data.VectorStoreID.ValueString()
 	if vectorStoreID == "" {
 		vectorStoreID = data.ID.ValueString()
@@ -217,8 +234,8 @@ func (r *VectorStoreResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	if err := r.client.DoRequestWithResponse(ctx, "POST", "/vector_store/delete", deleteReq, nil); err != nil {
-		if !IsNotFoundError(err) {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete vector store: %s", err))
+		if !isAlreadyDeletedError(err) {
+			addClientError(ctx, &resp.Diagnostics, req.State.Schema, "Unable to delete vector store", err)
 			return
 		}
 	}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ServerInfo describes the LiteLLM proxy the provider is connected to, as
+// reported by its readiness endpoint and OpenAPI document.
+type ServerInfo struct {
+	Version          string
+	Status           string
+	DB               string
+	Cache            string
+	SuccessCallbacks []string
+
+	// routes holds the paths listed in the proxy's OpenAPI document. It is
+	// nil when the document could not be read.
+	routes map[string]bool
+}
+
+// serverProbeTimeout bounds each request made to detect the connected
+// server, so that an unreachable proxy delays planning only briefly.
+const serverProbeTimeout = 5 * time.Second
+
+// serverCapability is an API feature that only some LiteLLM releases
+// provide. It is detected by the route that implements it, so no release
+// numbers have to be maintained.
+type serverCapability struct {
+	Name  string
+	Route string
+}
+
+// Capabilities the provider selects endpoints or validates configuration by.
+var (
+	capabilityPromptByID = serverCapability{Name: "prompt_by_id_route", Route: "/prompts/{prompt_id}"}
+	capabilityAgents     = serverCapability{Name: "agents", Route: "/v1/agents"}
+	capabilityProjects   = serverCapability{Name: "projects", Route: "/project/new"}
+)
+
+// serverCapabilities lists every capability, in the order reported by the
+// litellm_server_info data source.
+var serverCapabilities = []serverCapability{
+	capabilityPromptByID,
+	capabilityAgents,
+	capabilityProjects,
+}
+
+// supports reports whether the connected server provides cap. When the
+// server's routes are unknown the provider assumes a current release.
+func (info *ServerInfo) supports(cap serverCapability) bool {
+	if info == nil || info.routes == nil {
+		return true
+	}
+	return info.routes[cap.Route]
+}
+
+// serverProbe detects the connected server the first time it is needed, so
+// that configurations which use no version-dependent feature never wait for
+// it. Only a successful probe is kept: the first caller's context may be
+// cancelled or time out, and the next caller then probes again.
+type serverProbe struct {
+	mu   sync.Mutex
+	info *ServerInfo
+}
+
+// serverInfo returns the detected server, probing it on first use. It
+// returns nil when detection failed.
+func (c *Client) serverInfo(ctx context.Context) *ServerInfo {
+	if c.ServerInfo != nil || c.probe == nil {
+		return c.ServerInfo
+	}
+	c.probe.mu.Lock()
+	defer c.probe.mu.Unlock()
+	if c.probe.info == nil {
+		c.probe.info = c.detectServerInfo(ctx)
+	}
+	return c.probe.info
+}
+
+// supports reports whether the connected server provides cap.
+func (c *Client) supports(ctx context.Context, cap serverCapability) bool {
+	return c.serverInfo(ctx).supports(cap)
+}
+
+// requireCapability adds an error diagnostic when the connected server is
+// known not to provide cap. feature names what the configuration asked for, and
+// attrPath points at the offending attribute; an empty path reports a
+// resource-level error.
+func (c *Client) requireCapability(ctx context.Context, diags *diag.Diagnostics, cap serverCapability, feature string, attrPath path.Path) {
+	if c == nil || c.supports(ctx, cap) {
+		return
+	}
+
+	proxy := "the connected proxy"
+	if version := c.serverInfo(ctx).Version; version != "" {
+		proxy = fmt.Sprintf("the connected proxy (v%s)", version)
+	}
+	summary := "Unsupported by LiteLLM Server Version"
+	detail := fmt.Sprintf("%s requires the %s endpoint, which %s does not provide. "+
+		"Upgrade the LiteLLM proxy or remove it from the configuration.", feature, cap.Route, proxy)
+	if attrPath.Equal(path.Empty()) {
+		diags.AddError(summary, detail)
+		return
+	}
+	diags.AddAttributeError(attrPath, summary, detail)
+}
+
+// fetchServerInfo queries the proxy's readiness endpoint and OpenAPI
+// document. Each request is a single attempt bounded by serverProbeTimeout.
+// A missing OpenAPI document, for example when the proxy disables its docs,
+// leaves the routes unknown.
+func (c *Client) fetchServerInfo(ctx context.Context) (*ServerInfo, error) {
+	probe := *c
+	probe.MaxRetries = 0
+
+	readinessCtx, cancel := context.WithTimeout(ctx, serverProbeTimeout)
+	defer cancel()
+
+	var result map[string]interface{}
+	if err := probe.DoRequestWithResponse(readinessCtx, "GET", "/health/readiness", nil, &result); err != nil {
+		return nil, err
+	}
+
+	info := &ServerInfo{}
+	if v, ok := result["litellm_version"].(string); ok {
+		info.Version = v
+	}
+	if v, ok := result["status"].(string); ok {
+		info.Status = v
+	}
+	if v, ok := result["db"].(string); ok {
+		info.DB = v
+	}
+	if v, ok := result["cache"].(string); ok {
+		info.Cache = v
+	}
+	if callbacks, ok := result["success_callbacks"].([]interface{}); ok {
+		for _, cb := range callbacks {
+			if s, ok := cb.(string); ok {
+				info.SuccessCallbacks = append(info.SuccessCallbacks, s)
+			}
+		}
+	}
+
+	openAPICtx, cancelOpenAPI := context.WithTimeout(ctx, serverProbeTimeout)
+	defer cancelOpenAPI()
+
+	var document struct {
+		Paths map[string]json.RawMessage `json:"paths"`
+	}
+	if err := probe.DoRequestWithResponse(openAPICtx, "GET", "/openapi.json", nil, &document); err != nil {
+		// A cancelled operation says nothing about the proxy; report it so
+		// that the next caller probes again.
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		tflog.Warn(ctx, "Unable to read the LiteLLM OpenAPI document; assuming a current release", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return info, nil
+	}
+	info.routes = make(map[string]bool, len(document.Paths))
+	for route := range document.Paths {
+		info.routes[route] = true
+	}
+
+	return info, nil
+}
+
+// detectServerInfo probes the connected server. Failures are logged rather
+// than reported: the provider then assumes a current LiteLLM release.
+func (c *Client) detectServerInfo(ctx context.Context) *ServerInfo {
+	info, err := c.fetchServerInfo(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to detect LiteLLM server version; assuming a current release", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil
+	}
+	if info.Version == "" {
+		tflog.Warn(ctx, "LiteLLM readiness endpoint did not report a version")
+	} else {
+		tflog.Info(ctx, "Detected LiteLLM server version", map[string]interface{}{
+			"version": info.Version,
+		})
+	}
+	return info
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestServerInfoProbedOnFirstUse(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/health/readiness":
+			_, _ = w.Write([]byte(`{"status":"healthy","db":"connected","cache":"redis","litellm_version":"1.79.1","success_callbacks":["langfuse"]}`))
+		case "/openapi.json":
+			_, _ = w.Write([]byte(`{"paths":{"/prompts/{prompt_id}":{},"/v1/agents":{}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client(), probe: &serverProbe{}}
+	if requests.Load() != 0 {
+		t.Fatal("the server should not be probed before a capability is needed")
+	}
+
+	info := client.serverInfo(context.Background())
+	if info == nil {
+		t.Fatal("ServerInfo was not detected")
+	}
+	if info.Version != "1.79.1" || info.Status != "healthy" || info.DB != "connected" || info.Cache != "redis" {
+		t.Errorf("ServerInfo = %+v", info)
+	}
+	if len(info.SuccessCallbacks) != 1 || info.SuccessCallbacks[0] != "langfuse" {
+		t.Errorf("SuccessCallbacks = %v", info.SuccessCallbacks)
+	}
+
+	if !client.supports(context.Background(), capabilityPromptByID) {
+		t.Error("a proxy serving /prompts/{prompt_id} should support prompt_by_id_route")
+	}
+	if client.supports(context.Background(), capabilityProjects) {
+		t.Errorf("a proxy without %s should not support projects", capabilityProjects.Route)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2 (the server is probed once)", got)
+	}
+}
+
+func TestServerInfoProbedAgainAfterFailure(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/health/readiness":
+			_, _ = w.Write([]byte(`{"status":"healthy","litellm_version":"1.79.1"}`))
+		case "/openapi.json":
+			_, _ = w.Write([]byte(`{"paths":{"/v1/agents":{}}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client(), probe: &serverProbe{}}
+
+	// The first caller's operation was already cancelled.
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if info := client.serverInfo(cancelled); info != nil {
+		t.Fatalf("a cancelled probe should not detect the server, got %+v", info)
+	}
+
+	info := client.serverInfo(context.Background())
+	if info == nil || info.Version != "1.79.1" {
+		t.Fatalf("the server should be probed again after a failed probe, got %+v", info)
+	}
+	if client.supports(context.Background(), capabilityProjects) {
+		t.Errorf("the routes of the second probe should be used")
+	}
+}
+
+func TestServerInfo_unknownRoutesAssumeCurrentRelease(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health/readiness" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"healthy","litellm_version":"1.79.1"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{APIBase: server.URL, APIKey: "test-key", HTTPClient: server.Client(), probe: &serverProbe{}}
+	for _, cap := range serverCapabilities {
+		if !client.supports(context.Background(), cap) {
+			t.Errorf("a proxy without an OpenAPI document should support %s", cap.Name)
+		}
+	}
+
+	var unknown *ServerInfo
+	for _, cap := range serverCapabilities {
+		if !unknown.supports(cap) {
+			t.Errorf("nil ServerInfo should support %s", cap.Name)
+		}
+	}
+
+	if got := promptInfoEndpoint(context.Background(), &Client{}, "p1"); got != "/prompts/p1" {
+		t.Errorf("promptInfoEndpoint = %q, want /prompts/p1", got)
+	}
+}
+
+func TestRequireCapability(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &Client{ServerInfo: &ServerInfo{Version: "1.76.0", routes: map[string]bool{"/prompts/{prompt_id}/info": true}}}
+
+	if got := promptInfoEndpoint(ctx, client, "p1"); got != "/prompts/p1/info" {
+		t.Errorf("promptInfoEndpoint = %q, want /prompts/p1/info on older servers", got)
+	}
+
+	var diags diag.Diagnostics
+	client.requireCapability(ctx, &diags, capabilityProjects, "Setting project_id", path.Root("project_id"))
+	if len(diags) != 1 {
+		t.Fatalf("diagnostics = %d, want 1", len(diags))
+	}
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("project_id")) {
+		t.Errorf("diagnostic = %#v, want attribute error at project_id", diags[0])
+	}
+	want := "Setting project_id requires the /project/new endpoint, which the connected proxy (v1.76.0) does not provide. Upgrade the LiteLLM proxy or remove it from the configuration."
+	if diags[0].Detail() != want {
+		t.Errorf("Detail() = %q", diags[0].Detail())
+	}
+
+	diags = nil
+	client.ServerInfo.Version = ""
+	client.requireCapability(ctx, &diags, capabilityProjects, "Setting project_id", path.Root("project_id"))
+	want = "Setting project_id requires the /project/new endpoint, which the connected proxy does not provide. Upgrade the LiteLLM proxy or remove it from the configuration."
+	if len(diags) != 1 || diags[0].Detail() != want {
+		t.Errorf("without a version, diagnostics = %v", diags)
+	}
+
+	diags = nil
+	(&Client{}).requireCapability(ctx, &diags, capabilityProjects, "Setting project_id", path.Root("project_id"))
+	if diags.HasError() {
+		t.Error("an undetected server should not produce diagnostics")
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Every resource accepts a standard timeouts block. A configured timeout
+// becomes the deadline of the operation's context: it bounds the whole
+// operation, each HTTP request within it is still bounded by request_timeout,
+// and reading back a newly created object retries until the deadline instead
+// of stopping after a fixed number of attempts (see readBackAttempts).
+// Operations without a configured timeout behave as before.
+
+// timeoutsBlock returns the timeouts block shared by all resources.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// withTimeout bounds ctx by the timeout an operation's timeouts block
+// configures. timeout is the timeouts.Value method for the operation, such as
+// data.Timeouts.Create.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	d, timeoutDiags := timeout(ctx, 0)
+	diags.Append(timeoutDiags...)
+	if d <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, d)
+}
+
+// nullTimeouts returns an unset timeouts value for models that are built from
+// scratch rather than read from a plan or state.
+func nullTimeouts(ctx context.Context) timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(timeoutsBlock(ctx).Type().(timeouts.Type).AttrTypes),
+	}
+}
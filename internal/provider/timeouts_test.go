@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	unset := nullTimeouts(ctx)
+	unsetCtx, cancel := withTimeout(ctx, unset.Create, &diags)
+	defer cancel()
+	if _, ok := unsetCtx.Deadline(); ok {
+		t.Fatal("expected no deadline without a configured timeout")
+	}
+
+	attrTypes := unset.Object.AttributeTypes(ctx)
+	values := make(map[string]attr.Value, len(attrTypes))
+	for name := range attrTypes {
+		values[name] = types.StringNull()
+	}
+	values["create"] = types.StringValue("45m")
+	configured := timeouts.Value{Object: types.ObjectValueMust(attrTypes, values)}
+
+	configuredCtx, cancel := withTimeout(ctx, configured.Create, &diags)
+	defer cancel()
+	deadline, ok := configuredCtx.Deadline()
+	if !ok {
+		t.Fatal("expected a deadline from the configured create timeout")
+	}
+	if remaining := time.Until(deadline); remaining < 44*time.Minute || remaining > 45*time.Minute {
+		t.Fatalf("expected a deadline about 45m away, got %s", remaining)
+	}
+
+	readCtx, cancel := withTimeout(ctx, configured.Read, &diags)
+	defer cancel()
+	if _, ok := readCtx.Deadline(); ok {
+		t.Fatal("expected no deadline for an operation without a configured timeout")
+	}
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// defaultRequestTimeout bounds a single HTTP round trip when request_timeout
+// is not configured.
+const defaultRequestTimeout = 30 * time.Second
+
+// transportConfig holds the resolved provider settings that shape the HTTP
+// transport used to reach the LiteLLM proxy.
+type transportConfig struct {
+	InsecureSkipVerify bool
+	CACertPEM          string
+	CACertFile         string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	ProxyURL           string
+}
+
+// buildHTTPTransport returns an http.Transport configured with the custom CA
+// bundle, client certificate and proxy from cfg.
+func buildHTTPTransport(cfg transportConfig) (*http.Transport, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+
+	caPEM := []byte(cfg.CACertPEM)
+	if cfg.CACertFile != "" {
+		if cfg.CACertPEM != "" {
+			return nil, fmt.Errorf("only one of ca_cert_pem and ca_cert_file may be set")
+		}
+		b, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_cert_file: %w", err)
+		}
+		caPEM = b
+	}
+	if len(caPEM) > 0 {
+		// Start from the system pool so public endpoints keep working when the
+		// bundle only adds an internal CA.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid PEM certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertPEM != "" || cfg.ClientKeyPEM != "" {
+		if cfg.ClientCertPEM == "" || cfg.ClientKeyPEM == "" {
+			return nil, fmt.Errorf("client_cert_pem and client_key_pem must be set together")
+		}
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	tr := &http.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url %q: expected an absolute URL such as http://proxy.internal:3128", cfg.ProxyURL)
+		}
+		tr.Proxy = http.ProxyURL(proxy)
+	}
+
+	return tr, nil
+}
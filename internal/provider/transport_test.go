@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testServerPEM returns the PEM-encoded certificate and private key of a
+// httptest TLS server.
+func testServerPEM(t *testing.T, server *httptest.Server) (string, string) {
+	t.Helper()
+	cert := server.TLS.Certificates[0]
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey: %v", err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestBuildHTTPTransport_customCAAndClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	certPEM, keyPEM := testServerPEM(t, server)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(certPEM), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	tr, err := buildHTTPTransport(transportConfig{
+		CACertFile:    caFile,
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if err != nil {
+		t.Fatalf("buildHTTPTransport: %v", err)
+	}
+
+	resp, err := (&http.Client{Transport: tr}).Get(server.URL)
+	if err != nil {
+		t.Fatalf("GET with custom CA and client certificate: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+
+	// Without the CA bundle the server certificate must be rejected.
+	plain, err := buildHTTPTransport(transportConfig{ClientCertPEM: certPEM, ClientKeyPEM: keyPEM})
+	if err != nil {
+		t.Fatalf("buildHTTPTransport: %v", err)
+	}
+	if _, err := (&http.Client{Transport: plain}).Get(server.URL); err == nil {
+		t.Error("expected certificate verification failure without ca_cert_file")
+	}
+}
+
+func TestBuildHTTPTransport_invalidConfiguration(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]transportConfig{
+		"invalid CA PEM":       {CACertPEM: "not a certificate"},
+		"missing CA file":      {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"CA PEM and CA file":   {CACertPEM: "x", CACertFile: "y"},
+		"client cert only":     {ClientCertPEM: "cert"},
+		"invalid client pair":  {ClientCertPEM: "cert", ClientKeyPEM: "key"},
+		"relative proxy URL":   {ProxyURL: "proxy.internal:3128"},
+		"unparseable proxyURL": {ProxyURL: "http://[::1"},
+	}
+	for name, cfg := range cases {
+		if _, err := buildHTTPTransport(cfg); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestBuildHTTPTransport_proxyURL(t *testing.T) {
+	t.Parallel()
+
+	tr, err := buildHTTPTransport(transportConfig{ProxyURL: "http://proxy.internal:3128"})
+	if err != nil {
+		t.Fatalf("buildHTTPTransport: %v", err)
+	}
+	req, _ := http.NewRequest("GET", "https://litellm.example.com/key/info", nil)
+	proxy, err := tr.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.internal:3128" {
+		t.Errorf("Proxy() = %v, %v; want proxy.internal:3128", proxy, err)
+	}
+}
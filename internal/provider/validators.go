@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// limitTypeValues are the tpm_limit_type and rpm_limit_type values LiteLLM
+// accepts.
+var limitTypeValues = []string{"guaranteed_throughput", "best_effort_throughput", "dynamic"}
+
+// guardrailModes are the event hooks a guardrail can run on.
+var guardrailModes = []string{"pre_call", "post_call", "during_call", "logging_only", "pre_mcp_call", "during_mcp_call"}
+
+var _ validator.String = litellmDurationValidator{}
+
+// litellmDurationValidator checks that a string is a LiteLLM duration such as
+// "30d" or "1h".
+type litellmDurationValidator struct {
+	// allowNever also accepts "-1", which LiteLLM uses for keys that never
+	// expire.
+	allowNever bool
+}
+
+func litellmDuration() validator.String {
+	return litellmDurationValidator{}
+}
+
+// litellmDurationOrNever is litellmDuration for a key's duration, which also
+// accepts "-1".
+func litellmDurationOrNever() validator.String {
+	return litellmDurationValidator{allowNever: true}
+}
+
+func (v litellmDurationValidator) Description(ctx context.Context) string {
+	if v.allowNever {
+		return "value must be a LiteLLM duration: a number followed by s, m, h, d, w or mo (e.g. \"30d\"), or \"-1\" to never expire"
+	}
+	return "value must be a LiteLLM duration: a number followed by s, m, h, d, w or mo (e.g. \"30d\")"
+}
+
+func (v litellmDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v litellmDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if v.allowNever && req.ConfigValue.ValueString() == "-1" {
+		return
+	}
+	if _, err := parseLiteLLMDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", err.Error())
+	}
+}
+
+var _ validator.String = guardrailModeValidator{}
+
+// guardrailModeValidator checks that a guardrail mode is a single mode or a
+// JSON array of modes.
+type guardrailModeValidator struct{}
+
+func guardrailMode() validator.String {
+	return guardrailModeValidator{}
+}
+
+func (v guardrailModeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of %s, or a JSON array of them", strings.Join(guardrailModes, ", "))
+}
+
+func (v guardrailModeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v guardrailModeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	modes := []string{value}
+	if strings.HasPrefix(value, "[") {
+		if err := json.Unmarshal([]byte(value), &modes); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Guardrail Mode",
+				fmt.Sprintf("%q is not a JSON array of strings: %s", value, err))
+			return
+		}
+		if len(modes) == 0 {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Guardrail Mode", "The list of modes must not be empty.")
+			return
+		}
+	}
+
+	for _, mode := range modes {
+		if !isGuardrailMode(mode) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Guardrail Mode",
+				fmt.Sprintf("%q is not a guardrail mode: %s.", mode, v.Description(ctx)))
+		}
+	}
+}
+
+func isGuardrailMode(mode string) bool {
+	for _, m := range guardrailModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
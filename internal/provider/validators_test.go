@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// resourceObjectValue returns an object of r's schema holding values; all
+// other attributes are null.
+func resourceObjectValue(t *testing.T, r resource.Resource, values map[string]tftypes.Value) (tftypes.Value, resource.SchemaResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, attrType := range objType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+			continue
+		}
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	return tftypes.NewValue(objType, attrs), schemaResp
+}
+
+// validateResourceConfig runs the config validators and ValidateConfig of r
+// against a config holding values; all other attributes are null.
+func validateResourceConfig(t *testing.T, r resource.Resource, values map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+
+	ctx := context.Background()
+	raw, schemaResp := resourceObjectValue(t, r, values)
+	config := tfsdk.Config{Raw: raw, Schema: schemaResp.Schema}
+
+	var diags diag.Diagnostics
+	if rv, ok := r.(resource.ResourceWithConfigValidators); ok {
+		for _, v := range rv.ConfigValidators(ctx) {
+			var resp resource.ValidateConfigResponse
+			v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+	}
+	if rv, ok := r.(resource.ResourceWithValidateConfig); ok {
+		var resp resource.ValidateConfigResponse
+		rv.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return diags
+}
+
+func validateString(v validator.String, value types.String) diag.Diagnostics {
+	var resp validator.StringResponse
+	v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: value}, &resp)
+	return resp.Diagnostics
+}
+
+func TestLiteLLMDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []types.String{types.StringValue("30d"), types.StringValue("1mo"), types.StringNull(), types.StringUnknown()} {
+		if diags := validateString(litellmDuration(), value); diags.HasError() {
+			t.Errorf("%s: unexpected diagnostics: %v", value, diags)
+		}
+	}
+	for _, value := range []string{"monthly", "30", "1.5h", "-1"} {
+		if diags := validateString(litellmDuration(), types.StringValue(value)); !diags.HasError() {
+			t.Errorf("%s: expected an error", value)
+		}
+	}
+
+	if diags := validateString(litellmDurationOrNever(), types.StringValue("-1")); diags.HasError() {
+		t.Errorf("-1: unexpected diagnostics for a key duration: %v", diags)
+	}
+	if diags := validateString(litellmDurationOrNever(), types.StringValue("-2")); !diags.HasError() {
+		t.Error("-2: expected an error for a key duration")
+	}
+}
+
+func TestGuardrailModeValidator(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"pre_call", "during_call", `["pre_call","post_call"]`, "logging_only"} {
+		if diags := validateString(guardrailMode(), types.StringValue(value)); diags.HasError() {
+			t.Errorf("%s: unexpected diagnostics: %v", value, diags)
+		}
+	}
+	for _, value := range []string{"before_call", `["pre_call","after_call"]`, `[]`, `["pre_call"`} {
+		if diags := validateString(guardrailMode(), types.StringValue(value)); !diags.HasError() {
+			t.Errorf("%s: expected an error", value)
+		}
+	}
+}
+
+func TestModelResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"thinking enabled": {
+			values: map[string]tftypes.Value{
+				"thinking_enabled":       tftypes.NewValue(tftypes.Bool, true),
+				"thinking_budget_tokens": tftypes.NewValue(tftypes.Number, 2048),
+			},
+		},
+		"thinking budget without thinking": {
+			values: map[string]tftypes.Value{
+				"thinking_budget_tokens": tftypes.NewValue(tftypes.Number, 2048),
+			},
+			wantErr: true,
+		},
+		"credential with region": {
+			values: map[string]tftypes.Value{
+				"litellm_credential_name": tftypes.NewValue(tftypes.String, "bedrock"),
+				"aws_region_name":         tftypes.NewValue(tftypes.String, "us-east-1"),
+			},
+		},
+		"credential with inline secret": {
+			values: map[string]tftypes.Value{
+				"litellm_credential_name": tftypes.NewValue(tftypes.String, "bedrock"),
+				"aws_secret_access_key":   tftypes.NewValue(tftypes.String, "secret"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, &ModelResource{}, tt.values)
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantErr, diags)
+		}
+	}
+}
+
+func TestMCPServerResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"stdio with command": {
+			values: map[string]tftypes.Value{
+				"transport": tftypes.NewValue(tftypes.String, "stdio"),
+				"command":   tftypes.NewValue(tftypes.String, "python3"),
+			},
+		},
+		"stdio without command": {
+			values: map[string]tftypes.Value{
+				"transport": tftypes.NewValue(tftypes.String, "stdio"),
+			},
+			wantErr: true,
+		},
+		"http with command": {
+			values: map[string]tftypes.Value{
+				"transport": tftypes.NewValue(tftypes.String, "http"),
+				"command":   tftypes.NewValue(tftypes.String, "python3"),
+			},
+			wantErr: true,
+		},
+		"http": {
+			values: map[string]tftypes.Value{
+				"transport": tftypes.NewValue(tftypes.String, "http"),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, &MCPServerResource{}, tt.values)
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantErr, diags)
+		}
+	}
+}
+
+func TestKeyResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"auto rotation": {
+			values: map[string]tftypes.Value{
+				"auto_rotate":       tftypes.NewValue(tftypes.Bool, true),
+				"rotation_interval": tftypes.NewValue(tftypes.String, "30d"),
+			},
+		},
+		"auto rotation without interval": {
+			values: map[string]tftypes.Value{
+				"auto_rotate": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantErr: true,
+		},
+		"rotation trigger with predefined key": {
+			values: map[string]tftypes.Value{
+				"key":              tftypes.NewValue(tftypes.String, "sk-1234"),
+				"rotation_trigger": tftypes.NewValue(tftypes.String, "1"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		diags := validateResourceConfig(t, &KeyResource{}, tt.values)
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("%s: expected error %t, got %v", name, tt.wantErr, diags)
+		}
+	}
+}
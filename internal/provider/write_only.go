@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secrets can be supplied through write-only "_wo" attributes, which
+// Terraform (1.11 and later) never stores in the plan or state. Because the
+// value cannot be compared between runs, each one is paired with a
+// "_wo_version" attribute: changing the version triggers an update that sends
+// the current write-only value. Write-only values are only available from the
+// configuration, so Create and Update copy them from req.Config.
+
+// writeOnlyDescription documents a write-only counterpart of attr.
+func writeOnlyDescription(description, attr string) string {
+	return fmt.Sprintf("%s Write-only: never stored in the plan or state (requires Terraform 1.11 or later). Conflicts with %s. Change %s_wo_version to apply a new value.", description, attr, attr)
+}
+
+// writeOnlyStringAttribute returns the write-only, sensitive counterpart of
+// the string attribute attr.
+func writeOnlyStringAttribute(description, attr string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: writeOnlyDescription(description, attr),
+		Optional:    true,
+		Sensitive:   true,
+		WriteOnly:   true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot(attr)),
+		},
+	}
+}
+
+// writeOnlyVersionAttribute returns the version trigger for the write-only
+// attribute woAttr.
+func writeOnlyVersionAttribute(woAttr string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("Version of %s. Terraform cannot detect changes to write-only values, so change this number whenever %s changes to send the new value to LiteLLM.", woAttr, woAttr),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(woAttr)),
+		},
+	}
+}
+
+// secretValue returns the value of a secret that can be set through either a
+// regular attribute or its write-only counterpart, and whether one was set.
+func secretValue(value, writeOnly types.String) (string, bool) {
+	if !writeOnly.IsNull() && !writeOnly.IsUnknown() && writeOnly.ValueString() != "" {
+		return writeOnly.ValueString(), true
+	}
+	if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+		return value.ValueString(), true
+	}
+	return "", false
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecretValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		value     types.String
+		writeOnly types.String
+		want      string
+		wantOK    bool
+	}{
+		{"write-only preferred", types.StringValue("plain"), types.StringValue("secret"), "secret", true},
+		{"regular value", types.StringValue("plain"), types.StringNull(), "plain", true},
+		{"empty write-only falls back", types.StringValue("plain"), types.StringValue(""), "plain", true},
+		{"unknown ignored", types.StringUnknown(), types.StringNull(), "", false},
+		{"neither set", types.StringNull(), types.StringNull(), "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := secretValue(tt.value, tt.writeOnly)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("%s: secretValue() = (%q, %v), want (%q, %v)", tt.name, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestBuildCredentialRequestUsesWriteOnlyValues(t *testing.T) {
+	t.Parallel()
+
+	values, _ := types.MapValue(types.StringType, map[string]attr.Value{
+		"api_key": types.StringValue("sk-write-only"),
+	})
+	data := &CredentialResourceModel{
+		CredentialName:     types.StringValue("openai"),
+		CredentialInfo:     types.MapNull(types.StringType),
+		CredentialValues:   types.MapNull(types.StringType),
+		CredentialValuesWO: values,
+	}
+
+	r := &CredentialResource{}
+	body := r.buildCredentialRequest(context.Background(), data)
+
+	credValues, ok := body["credential_values"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("credential_values missing from request: %v", body)
+	}
+	if credValues["api_key"] != "sk-write-only" {
+		t.Errorf("api_key = %v, want sk-write-only", credValues["api_key"])
+	}
+}